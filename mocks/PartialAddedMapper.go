@@ -1,34 +0,0 @@
-// Code generated by mockery v1.0.0. DO NOT EDIT.
-
-package mocks
-
-import mock "github.com/stretchr/testify/mock"
-import sdk "github.com/proximax-storage/go-xpx-chain-sdk/sdk"
-
-// PartialAddedMapper is an autogenerated mock type for the PartialAddedMapper type
-type PartialAddedMapper struct {
-	mock.Mock
-}
-
-// MapPartialAdded provides a mock function with given fields: m
-func (_m *PartialAddedMapper) MapPartialAdded(m []byte) (*sdk.AggregateTransaction, error) {
-	ret := _m.Called(m)
-
-	var r0 *sdk.AggregateTransaction
-	if rf, ok := ret.Get(0).(func([]byte) *sdk.AggregateTransaction); ok {
-		r0 = rf(m)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sdk.AggregateTransaction)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func([]byte) error); ok {
-		r1 = rf(m)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
@@ -0,0 +1,51 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mocks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+// mocks must stay in sync with the sdk service interfaces, run `go generate ./sdk/...` after changing them
+var (
+	_ sdk.AccountService           = (*AccountService)(nil)
+	_ sdk.BlockchainService        = (*BlockchainService)(nil)
+	_ sdk.ContractService          = (*ContractService)(nil)
+	_ sdk.ExchangeService          = (*ExchangeService)(nil)
+	_ sdk.SdaExchangeService       = (*SdaExchangeService)(nil)
+	_ sdk.LiquidityProviderService = (*LiquidityProviderService)(nil)
+	_ sdk.LockService              = (*LockService)(nil)
+	_ sdk.MetadataService          = (*MetadataService)(nil)
+	_ sdk.MetadataV2Service        = (*MetadataV2Service)(nil)
+	_ sdk.MosaicService            = (*MosaicService)(nil)
+	_ sdk.NamespaceService         = (*NamespaceService)(nil)
+	_ sdk.NetworkService           = (*NetworkService)(nil)
+	_ sdk.NodeService              = (*NodeService)(nil)
+	_ sdk.ResolverService          = (*ResolverService)(nil)
+	_ sdk.StorageService           = (*StorageService)(nil)
+	_ sdk.StorageV2Service         = (*StorageV2Service)(nil)
+	_ sdk.SuperContractService     = (*SuperContractService)(nil)
+	_ sdk.SuperContractV2Service   = (*SuperContractV2Service)(nil)
+	_ sdk.TransactionService       = (*TransactionService)(nil)
+)
+
+func TestAccountService(t *testing.T) {
+	address := &sdk.Address{Type: sdk.MijinTest, Address: "SBFBW6TUGLEWQIBCMTBMXXQORZKUP3WTVX36ZFE7"}
+	info := &sdk.AccountInfo{Address: address}
+
+	m := NewAccountService(t)
+	m.On("GetAccountInfo", mock.Anything, address).Return(info, nil).Once()
+
+	client := &sdk.Client{Account: m}
+	actual, err := client.Account.GetAccountInfo(context.Background(), address)
+	assert.NoError(t, err)
+	assert.Equal(t, info, actual)
+}
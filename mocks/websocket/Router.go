@@ -1,20 +0,0 @@
-// Code generated by mockery v1.0.0. DO NOT EDIT.
-
-package mocks
-
-import mock "github.com/stretchr/testify/mock"
-
-// Router is an autogenerated mock type for the Router type
-type Router struct {
-	mock.Mock
-}
-
-// RouteMessage provides a mock function with given fields: _a0
-func (_m *Router) RouteMessage(_a0 []byte) {
-	_m.Called(_a0)
-}
-
-// SetUid provides a mock function with given fields: _a0
-func (_m *Router) SetUid(_a0 string) {
-	_m.Called(_a0)
-}
@@ -0,0 +1,44 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mocks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket/subs"
+)
+
+var (
+	_ websocket.Client           = (*Client)(nil)
+	_ websocket.CatapultClient   = (*CatapultClient)(nil)
+	_ websocket.MessagePublisher = (*MessagePublisher)(nil)
+	_ websocket.Topics           = (*Topics)(nil)
+)
+
+func TestSubscribe_MockedClient(t *testing.T) {
+	pool := subs.NewSubscribersPool[string](sdk.NewMapper[string](nil, func(_ *sdk.Hash, payload []byte) (string, error) {
+		return string(payload), nil
+	}))
+
+	topics := NewTopics(t)
+	topics.On("Pool", subs.Topic("dbrbEvent"), mock.Anything).Return(pool, nil)
+	topics.On("Subscribe", subs.NewPath("dbrbEvent", nil)).Return(nil).Once()
+	topics.On("Unsubscribe", subs.NewPath("dbrbEvent", nil)).Return(nil).Once()
+	client := NewCatapultClient(t)
+	client.On("Topics").Return(topics)
+
+	_, id, err := websocket.Subscribe[string](client, "dbrbEvent", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dbrbEvent"}, pool.GetPaths())
+
+	require.NoError(t, websocket.Unsubscribe(client, "dbrbEvent", nil, id))
+	assert.Empty(t, pool.GetPaths())
+}
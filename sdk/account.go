@@ -12,9 +12,28 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type AccountService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name AccountService --output ../mocks --outpkg mocks
+
+// AccountService provides account related REST endpoints: info, properties, multisig, names and harvesting
+type AccountService interface {
+	GetAccountProperties(ctx context.Context, address *Address) (*AccountProperties, error)
+	GetAccountsProperties(ctx context.Context, addresses ...*Address) ([]*AccountProperties, error)
+	GetAccountInfo(ctx context.Context, address *Address) (*AccountInfo, error)
+	GetAccountsInfo(ctx context.Context, addresses ...*Address) ([]*AccountInfo, error)
+	GetMultisigAccountInfo(ctx context.Context, address *Address) (*MultisigAccountInfo, error)
+	GetMultisigAccountGraphInfo(ctx context.Context, address *Address) (*MultisigAccountGraphInfo, error)
+	// GetMultisigGraph returns MultisigGraph of passed address built from GetMultisigAccountGraphInfo
+	GetMultisigGraph(ctx context.Context, address *Address) (*MultisigGraph, error)
+	// GetAccountNames Returns friendly names for accounts.
+	// post @/account/names
+	GetAccountNames(ctx context.Context, addr ...*Address) ([]*AccountName, error)
+	GetAccountHarvesting(ctx context.Context, address *Address) (*Harvester, error)
+	GetHarvesters(ctx context.Context, options *PaginationOrderingOptions) (*HarvestersPage, error)
+}
+
+type accountService service
 
-func (a *AccountService) GetAccountProperties(ctx context.Context, address *Address) (*AccountProperties, error) {
+func (a *accountService) GetAccountProperties(ctx context.Context, address *Address) (*AccountProperties, error) {
 	if address == nil {
 		return nil, ErrNilAddress
 	}
@@ -39,7 +58,7 @@ func (a *AccountService) GetAccountProperties(ctx context.Context, address *Addr
 	return dto.toStruct()
 }
 
-func (a *AccountService) GetAccountsProperties(ctx context.Context, addresses ...*Address) ([]*AccountProperties, error) {
+func (a *accountService) GetAccountsProperties(ctx context.Context, addresses ...*Address) ([]*AccountProperties, error) {
 	if len(addresses) == 0 {
 		return nil, ErrEmptyAddressesIds
 	}
@@ -68,7 +87,7 @@ func (a *AccountService) GetAccountsProperties(ctx context.Context, addresses ..
 	return dtos.toStruct()
 }
 
-func (a *AccountService) GetAccountInfo(ctx context.Context, address *Address) (*AccountInfo, error) {
+func (a *accountService) GetAccountInfo(ctx context.Context, address *Address) (*AccountInfo, error) {
 	if address == nil {
 		return nil, ErrNilAddress
 	}
@@ -93,7 +112,7 @@ func (a *AccountService) GetAccountInfo(ctx context.Context, address *Address) (
 	return dto.toStruct(a.client.config.reputationConfig)
 }
 
-func (a *AccountService) GetAccountsInfo(ctx context.Context, addresses ...*Address) ([]*AccountInfo, error) {
+func (a *accountService) GetAccountsInfo(ctx context.Context, addresses ...*Address) ([]*AccountInfo, error) {
 	if len(addresses) == 0 {
 		return nil, ErrEmptyAddressesIds
 	}
@@ -122,7 +141,7 @@ func (a *AccountService) GetAccountsInfo(ctx context.Context, addresses ...*Addr
 	return dtos.toStruct(a.client.config.reputationConfig)
 }
 
-func (a *AccountService) GetMultisigAccountInfo(ctx context.Context, address *Address) (*MultisigAccountInfo, error) {
+func (a *accountService) GetMultisigAccountInfo(ctx context.Context, address *Address) (*MultisigAccountInfo, error) {
 	if address == nil {
 		return nil, ErrNilAddress
 	}
@@ -143,7 +162,7 @@ func (a *AccountService) GetMultisigAccountInfo(ctx context.Context, address *Ad
 	return dto.toStruct(a.client.config.NetworkType)
 }
 
-func (a *AccountService) GetMultisigAccountGraphInfo(ctx context.Context, address *Address) (*MultisigAccountGraphInfo, error) {
+func (a *accountService) GetMultisigAccountGraphInfo(ctx context.Context, address *Address) (*MultisigAccountGraphInfo, error) {
 	if address == nil {
 		return nil, ErrNilAddress
 	}
@@ -164,9 +183,19 @@ func (a *AccountService) GetMultisigAccountGraphInfo(ctx context.Context, addres
 	return dto.toStruct(a.client.config.NetworkType)
 }
 
+// GetMultisigGraph returns MultisigGraph of passed address built from GetMultisigAccountGraphInfo
+func (a *accountService) GetMultisigGraph(ctx context.Context, address *Address) (*MultisigGraph, error) {
+	info, err := a.GetMultisigAccountGraphInfo(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMultisigGraph(info), nil
+}
+
 // GetAccountNames Returns friendly names for accounts.
 // post @/account/names
-func (a *AccountService) GetAccountNames(ctx context.Context, addr ...*Address) ([]*AccountName, error) {
+func (a *accountService) GetAccountNames(ctx context.Context, addr ...*Address) ([]*AccountName, error) {
 
 	if len(addr) == 0 {
 		return nil, ErrEmptyAddressesIds
@@ -186,7 +215,7 @@ func (a *AccountService) GetAccountNames(ctx context.Context, addr ...*Address)
 	return dtos.toStruct()
 }
 
-func (a *AccountService) GetAccountHarvesting(ctx context.Context, address *Address) (*Harvester, error) {
+func (a *accountService) GetAccountHarvesting(ctx context.Context, address *Address) (*Harvester, error) {
 	if address == nil {
 		return nil, ErrNilAddress
 	}
@@ -211,7 +240,7 @@ func (a *AccountService) GetAccountHarvesting(ctx context.Context, address *Addr
 	return dtos[0].toStruct()
 }
 
-func (a *AccountService) GetHarvesters(ctx context.Context, options *PaginationOrderingOptions) (*HarvestersPage, error) {
+func (a *accountService) GetHarvesters(ctx context.Context, options *PaginationOrderingOptions) (*HarvestersPage, error) {
 	dto := &harvestersPageDTO{}
 
 	u, err := addOptions(harvestersRoute, options)
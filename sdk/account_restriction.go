@@ -0,0 +1,323 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"time"
+)
+
+// AccountRestrictionPolicy is the desired state of account properties.
+// Values of a kind are either allowed or blocked, empty lists of both mean the kind is not restricted
+type AccountRestrictionPolicy struct {
+	AllowedAddresses   []*Address
+	BlockedAddresses   []*Address
+	AllowedMosaics     []*MosaicId
+	BlockedMosaics     []*MosaicId
+	AllowedEntityTypes []EntityType
+	BlockedEntityTypes []EntityType
+}
+
+// returns AccountRestrictionPolicy equal to the account properties
+func NewAccountRestrictionPolicy(properties *AccountProperties) *AccountRestrictionPolicy {
+	return &AccountRestrictionPolicy{
+		AllowedAddresses:   properties.AllowedAddresses,
+		BlockedAddresses:   properties.BlockedAddresses,
+		AllowedMosaics:     properties.AllowedMosaicId,
+		BlockedMosaics:     properties.BlockedMosaicId,
+		AllowedEntityTypes: properties.AllowedEntityTypes,
+		BlockedEntityTypes: properties.BlockedEntityTypes,
+	}
+}
+
+// Validate returns ErrRestrictionPolicyConflict when values of a kind are both allowed and blocked and
+// ErrRestrictionPolicyLockout when the account could not send the aggregate of property transactions undoing the policy
+func (p *AccountRestrictionPolicy) Validate() error {
+	if (len(p.AllowedAddresses) > 0 && len(p.BlockedAddresses) > 0) ||
+		(len(p.AllowedMosaics) > 0 && len(p.BlockedMosaics) > 0) ||
+		(len(p.AllowedEntityTypes) > 0 && len(p.BlockedEntityTypes) > 0) {
+		return ErrRestrictionPolicyConflict
+	}
+
+	for _, entityType := range p.undoEntityTypes() {
+		if !p.EntityTypeAllowed(entityType) {
+			return ErrRestrictionPolicyLockout
+		}
+	}
+
+	return nil
+}
+
+// EntityTypeAllowed returns false when the policy prevents the account from sending transactions of the type
+func (p *AccountRestrictionPolicy) EntityTypeAllowed(entityType EntityType) bool {
+	if len(p.AllowedEntityTypes) > 0 {
+		return containsEntityType(p.AllowedEntityTypes, entityType)
+	}
+
+	return !containsEntityType(p.BlockedEntityTypes, entityType)
+}
+
+// AddressAllowed returns false when the policy prevents interactions with the address
+func (p *AccountRestrictionPolicy) AddressAllowed(address *Address) bool {
+	contains := func(addresses []*Address) bool {
+		for _, a := range addresses {
+			if a.Address == address.Address {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(p.AllowedAddresses) > 0 {
+		return contains(p.AllowedAddresses)
+	}
+
+	return !contains(p.BlockedAddresses)
+}
+
+// MosaicAllowed returns false when the policy prevents the account from receiving the mosaic
+func (p *AccountRestrictionPolicy) MosaicAllowed(mosaicId *MosaicId) bool {
+	contains := func(ids []*MosaicId) bool {
+		for _, id := range ids {
+			if *id == *mosaicId {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(p.AllowedMosaics) > 0 {
+		return contains(p.AllowedMosaics)
+	}
+
+	return !contains(p.BlockedMosaics)
+}
+
+// undoEntityTypes returns transaction types needed to undo the policy with a complete aggregate
+func (p *AccountRestrictionPolicy) undoEntityTypes() []EntityType {
+	types := []EntityType{AggregateCompleted, AccountPropertyEntityType}
+
+	if len(p.AllowedAddresses) > 0 || len(p.BlockedAddresses) > 0 {
+		types = append(types, AccountPropertyAddress)
+	}
+
+	if len(p.AllowedMosaics) > 0 || len(p.BlockedMosaics) > 0 {
+		types = append(types, AccountPropertyMosaic)
+	}
+
+	return types
+}
+
+// AccountRestrictionManager brings account properties on chain to the state of a policy
+type AccountRestrictionManager struct {
+	Client *Client
+}
+
+// returns AccountRestrictionManager
+func NewAccountRestrictionManager(client *Client) *AccountRestrictionManager {
+	return &AccountRestrictionManager{Client: client}
+}
+
+// Properties returns account properties on chain. Properties are empty when the account has none
+func (m *AccountRestrictionManager) Properties(ctx context.Context, address *Address) (*AccountProperties, error) {
+	properties, err := m.Client.Account.GetAccountProperties(ctx, address)
+	if IsNotFound(err) {
+		return &AccountProperties{Address: address}, nil
+	}
+
+	return properties, err
+}
+
+// Diff returns the minimal property transactions changing current properties to the policy.
+// Address, mosaic and entity type transactions follow in this order so that new entity type
+// restrictions do not apply to the other transactions of the aggregate. For every kind the property
+// which is emptied is modified first, so the kind can switch between allowing and blocking
+func (m *AccountRestrictionManager) Diff(current *AccountProperties, policy *AccountRestrictionPolicy) ([]Transaction, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	deadline, err := m.Client.NewDeadline(time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]Transaction, 0)
+
+	addressKey := func(a *Address) string { return a.Address }
+	for _, propertyType := range propertyOrder(AllowAddress, BlockAddress, len(policy.AllowedAddresses) > 0) {
+		currentValues, desiredValues := current.AllowedAddresses, policy.AllowedAddresses
+		if propertyType == BlockAddress {
+			currentValues, desiredValues = current.BlockedAddresses, policy.BlockedAddresses
+		}
+
+		mods := make([]*AccountPropertiesAddressModification, 0)
+		removed, added := diffValues(currentValues, desiredValues, addressKey)
+		for _, a := range removed {
+			mods = append(mods, &AccountPropertiesAddressModification{ModificationType: RemoveProperty, Address: a})
+		}
+		for _, a := range added {
+			mods = append(mods, &AccountPropertiesAddressModification{ModificationType: AddProperty, Address: a})
+		}
+
+		if len(mods) == 0 {
+			continue
+		}
+
+		tx, err := m.Client.NewAccountPropertiesAddressTransaction(deadline, propertyType, mods)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+
+	mosaicKey := func(id *MosaicId) MosaicId { return *id }
+	for _, propertyType := range propertyOrder(AllowMosaic, BlockMosaic, len(policy.AllowedMosaics) > 0) {
+		currentValues, desiredValues := current.AllowedMosaicId, policy.AllowedMosaics
+		if propertyType == BlockMosaic {
+			currentValues, desiredValues = current.BlockedMosaicId, policy.BlockedMosaics
+		}
+
+		mods := make([]*AccountPropertiesMosaicModification, 0)
+		removed, added := diffValues(currentValues, desiredValues, mosaicKey)
+		for _, id := range removed {
+			mods = append(mods, &AccountPropertiesMosaicModification{ModificationType: RemoveProperty, AssetId: id})
+		}
+		for _, id := range added {
+			mods = append(mods, &AccountPropertiesMosaicModification{ModificationType: AddProperty, AssetId: id})
+		}
+
+		if len(mods) == 0 {
+			continue
+		}
+
+		tx, err := m.Client.NewAccountPropertiesMosaicTransaction(deadline, propertyType, mods)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+
+	entityTypeKey := func(t EntityType) EntityType { return t }
+	for _, propertyType := range propertyOrder(AllowTransaction, BlockTransaction, len(policy.AllowedEntityTypes) > 0) {
+		currentValues, desiredValues := current.AllowedEntityTypes, policy.AllowedEntityTypes
+		if propertyType == BlockTransaction {
+			currentValues, desiredValues = current.BlockedEntityTypes, policy.BlockedEntityTypes
+		}
+
+		mods := make([]*AccountPropertiesEntityTypeModification, 0)
+		removed, added := diffValues(currentValues, desiredValues, entityTypeKey)
+		for _, t := range removed {
+			mods = append(mods, &AccountPropertiesEntityTypeModification{ModificationType: RemoveProperty, EntityType: t})
+		}
+		for _, t := range added {
+			mods = append(mods, &AccountPropertiesEntityTypeModification{ModificationType: AddProperty, EntityType: t})
+		}
+
+		if len(mods) == 0 {
+			continue
+		}
+
+		tx, err := m.Client.NewAccountPropertiesEntityTypeTransaction(deadline, propertyType, mods)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// Aggregate returns the complete aggregate applying the policy to the account or nil when
+// properties on chain already match the policy. The aggregate should be signed by the account
+func (m *AccountRestrictionManager) Aggregate(ctx context.Context, account *PublicAccount, policy *AccountRestrictionPolicy) (*AggregateTransaction, error) {
+	if account == nil {
+		return nil, ErrNilAccount
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	current, err := m.Properties(ctx, account.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := m.Diff(current, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	for _, tx := range txs {
+		tx.GetAbstractTransaction().ToAggregate(account)
+	}
+
+	deadline, err := m.Client.NewDeadline(time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Client.NewCompleteAggregateTransaction(deadline, txs)
+}
+
+// propertyOrder returns the blocking property first when the allowing one is desired and vice versa
+func propertyOrder(allow, block PropertyType, allowDesired bool) []PropertyType {
+	if allowDesired {
+		return []PropertyType{block, allow}
+	}
+
+	return []PropertyType{allow, block}
+}
+
+// diffValues returns current values missing in desired ones and desired values missing in current ones.
+// Duplicates are ignored
+func diffValues[V any, K comparable](current, desired []V, key func(V) K) (removed, added []V) {
+	currentKeys := make(map[K]struct{}, len(current))
+	for _, v := range current {
+		currentKeys[key(v)] = struct{}{}
+	}
+
+	desiredKeys := make(map[K]struct{}, len(desired))
+	for _, v := range desired {
+		k := key(v)
+		if _, ok := desiredKeys[k]; ok {
+			continue
+		}
+		desiredKeys[k] = struct{}{}
+
+		if _, ok := currentKeys[k]; !ok {
+			added = append(added, v)
+		}
+	}
+
+	for _, v := range current {
+		k := key(v)
+		if _, ok := desiredKeys[k]; ok {
+			continue
+		}
+		// marks the value to skip current duplicates
+		desiredKeys[k] = struct{}{}
+		removed = append(removed, v)
+	}
+
+	return removed, added
+}
+
+func containsEntityType(types []EntityType, entityType EntityType) bool {
+	for _, t := range types {
+		if t == entityType {
+			return true
+		}
+	}
+
+	return false
+}
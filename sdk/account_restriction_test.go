@@ -0,0 +1,127 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAccountProperties struct {
+	AccountService
+	properties *AccountProperties
+}
+
+func (s *fakeAccountProperties) GetAccountProperties(context.Context, *Address) (*AccountProperties, error) {
+	if s.properties == nil {
+		return nil, ErrResourceNotFound
+	}
+
+	return s.properties, nil
+}
+
+func TestAccountRestrictionManager_Aggregate(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+	config.GenerationHash = &Hash{}
+
+	account, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	alice, err := NewAddressFromRaw("VAWOEOWTABXR7O3ZAK2XNA5GIBNE6PZIXDAFDWBU")
+	require.NoError(t, err)
+	bob, err := NewAddressFromRaw("VAWOEOWTABXR7O3ZAK2XNA5GIBNE6PZIXDAFDWBV")
+	require.NoError(t, err)
+	gold, err := NewMosaicId(0x1111)
+	require.NoError(t, err)
+	silver, err := NewMosaicId(0x2222)
+	require.NoError(t, err)
+
+	accounts := &fakeAccountProperties{}
+	client := NewClient(nil, config)
+	client.Account = accounts
+	markSynced(client)
+
+	manager := NewAccountRestrictionManager(client)
+
+	policy := &AccountRestrictionPolicy{
+		BlockedAddresses: []*Address{alice, alice},
+		AllowedMosaics:   []*MosaicId{gold},
+	}
+
+	// accounts without properties are not found
+	aggregate, err := manager.Aggregate(ctx, account.PublicAccount, policy)
+	require.NoError(t, err)
+	require.Len(t, aggregate.InnerTransactions, 2)
+
+	addresses := aggregate.InnerTransactions[0].(*AccountPropertiesAddressTransaction)
+	assert.Equal(t, BlockAddress, addresses.PropertyType)
+	assert.Equal(t, []*AccountPropertiesAddressModification{{ModificationType: AddProperty, Address: alice}}, addresses.Modifications)
+	assert.Equal(t, account.PublicAccount, addresses.Signer)
+
+	mosaics := aggregate.InnerTransactions[1].(*AccountPropertiesMosaicTransaction)
+	assert.Equal(t, AllowMosaic, mosaics.PropertyType)
+	assert.Equal(t, []*AccountPropertiesMosaicModification{{ModificationType: AddProperty, AssetId: gold}}, mosaics.Modifications)
+
+	_, err = account.Sign(aggregate)
+	require.NoError(t, err)
+
+	// allowing addresses empties blocked addresses first
+	accounts.properties = &AccountProperties{
+		Address:          account.Address,
+		BlockedAddresses: []*Address{alice},
+		AllowedMosaicId:  []*MosaicId{gold, silver},
+	}
+	policy = &AccountRestrictionPolicy{
+		AllowedAddresses: []*Address{bob},
+		AllowedMosaics:   []*MosaicId{gold},
+	}
+
+	txs, err := manager.Diff(accounts.properties, policy)
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+	assert.Equal(t, BlockAddress, txs[0].(*AccountPropertiesAddressTransaction).PropertyType)
+	assert.Equal(t, RemoveProperty, txs[0].(*AccountPropertiesAddressTransaction).Modifications[0].ModificationType)
+	assert.Equal(t, AllowAddress, txs[1].(*AccountPropertiesAddressTransaction).PropertyType)
+	assert.Equal(t, []*AccountPropertiesMosaicModification{{ModificationType: RemoveProperty, AssetId: silver}}, txs[2].(*AccountPropertiesMosaicTransaction).Modifications)
+
+	// properties matching the policy need no aggregate
+	accounts.properties = &AccountProperties{Address: account.Address, AllowedAddresses: []*Address{bob}, AllowedMosaicId: []*MosaicId{gold}}
+	aggregate, err = manager.Aggregate(ctx, account.PublicAccount, policy)
+	require.NoError(t, err)
+	assert.Nil(t, aggregate)
+}
+
+func TestAccountRestrictionPolicy_Validate(t *testing.T) {
+	alice, err := NewAddressFromRaw("VAWOEOWTABXR7O3ZAK2XNA5GIBNE6PZIXDAFDWBU")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name   string
+		policy *AccountRestrictionPolicy
+		err    error
+	}{
+		{"empty", &AccountRestrictionPolicy{}, nil},
+		{"conflict", &AccountRestrictionPolicy{AllowedAddresses: []*Address{alice}, BlockedAddresses: []*Address{alice}}, ErrRestrictionPolicyConflict},
+		{"blocked entity type property", &AccountRestrictionPolicy{BlockedEntityTypes: []EntityType{AccountPropertyEntityType}}, ErrRestrictionPolicyLockout},
+		{"blocked aggregate", &AccountRestrictionPolicy{BlockedEntityTypes: []EntityType{AggregateCompleted}}, ErrRestrictionPolicyLockout},
+		{"blocked transfer", &AccountRestrictionPolicy{BlockedEntityTypes: []EntityType{Transfer}}, nil},
+		{"allowed transfer only", &AccountRestrictionPolicy{AllowedEntityTypes: []EntityType{Transfer}}, ErrRestrictionPolicyLockout},
+		{"allowed undo", &AccountRestrictionPolicy{AllowedEntityTypes: []EntityType{Transfer, AggregateCompleted, AccountPropertyEntityType}}, nil},
+		{
+			"allowed undo without address property",
+			&AccountRestrictionPolicy{
+				BlockedAddresses:   []*Address{alice},
+				AllowedEntityTypes: []EntityType{AggregateCompleted, AccountPropertyEntityType},
+			},
+			ErrRestrictionPolicyLockout,
+		},
+	} {
+		assert.Equal(t, tc.err, tc.policy.Validate(), tc.name)
+	}
+}
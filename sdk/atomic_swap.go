@@ -0,0 +1,794 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultSwapPollInterval = time.Second * 5
+	DefaultSwapSafetyMargin = time.Minute * 10
+	swapProofSize           = 32
+	swapProofsPageSize      = 100
+)
+
+// errSwapPayloadExpired means the node doesn't know the persisted transaction and its deadline has passed
+var errSwapPayloadExpired = errors.New("persisted swap payload is expired")
+
+type SwapRole uint8
+
+// SwapRole enums
+const (
+	// SwapInitiator generates the proof, locks first and claims first
+	SwapInitiator SwapRole = iota
+	// SwapParticipant learns the secret from the initiator and claims with the revealed proof
+	SwapParticipant
+)
+
+type SwapStage uint8
+
+// SwapStage enums
+const (
+	SwapCreated SwapStage = iota
+	SwapLocked
+	SwapCounterpartyLocked
+	SwapProofRevealed
+	SwapClaimed
+	SwapRefunded
+	// SwapLockAnnounced and SwapClaimAnnounced are persisted before announcing, so a resumed swap
+	// waits for the announced transaction instead of announcing a new one
+	SwapLockAnnounced
+	SwapClaimAnnounced
+)
+
+func (s SwapStage) String() string {
+	switch s {
+	case SwapCreated:
+		return "created"
+	case SwapLocked:
+		return "locked"
+	case SwapCounterpartyLocked:
+		return "counterpartyLocked"
+	case SwapProofRevealed:
+		return "proofRevealed"
+	case SwapClaimed:
+		return "claimed"
+	case SwapRefunded:
+		return "refunded"
+	case SwapLockAnnounced:
+		return "lockAnnounced"
+	case SwapClaimAnnounced:
+		return "claimAnnounced"
+	}
+
+	return fmt.Sprintf("%d", uint8(s))
+}
+
+// IsFinal returns true if there is nothing left to do for the swap
+func (s SwapStage) IsFinal() bool {
+	return s == SwapClaimed || s == SwapRefunded
+}
+
+// SwapTerms describes one side of an atomic swap
+type SwapTerms struct {
+	HashType HashType
+	// Mosaic is locked on the local chain for Recipient
+	Mosaic    *Mosaic
+	Duration  Duration
+	Recipient *Address
+	// ExpectedMosaic is the minimal amount the counterparty should lock on the remote chain
+	ExpectedMosaic *Mosaic
+}
+
+// SwapState is a persistent state of an atomic swap. It is enough to resume the swap after a restart.
+type SwapState struct {
+	Id       string    `json:"id"`
+	Role     SwapRole  `json:"role"`
+	Stage    SwapStage `json:"stage"`
+	HashType HashType  `json:"hashType"`
+	Secret   string    `json:"secret"`
+	Proof    string    `json:"proof,omitempty"`
+
+	AssetId   uint64   `json:"assetId"`
+	Amount    Amount   `json:"amount"`
+	Duration  Duration `json:"duration"`
+	Recipient string   `json:"recipient"`
+
+	ExpectedAssetId uint64 `json:"expectedAssetId"`
+	ExpectedAmount  Amount `json:"expectedAmount"`
+
+	LockHash    string `json:"lockHash,omitempty"`
+	LockPayload string `json:"lockPayload,omitempty"`
+	// LockDeadline and ClaimDeadline are deadlines of the payloads in unix milliseconds
+	LockDeadline           int64  `json:"lockDeadline,omitempty"`
+	LockExpiry             Height `json:"lockExpiry,omitempty"`
+	CounterpartyLockExpiry Height `json:"counterpartyLockExpiry,omitempty"`
+	ClaimHash              string `json:"claimHash,omitempty"`
+	ClaimPayload           string `json:"claimPayload,omitempty"`
+	ClaimDeadline          int64  `json:"claimDeadline,omitempty"`
+}
+
+func (s *SwapState) secret() (*Secret, error) {
+	return NewSecretFromHexString(s.Secret, s.HashType)
+}
+
+func (s *SwapState) proof() (*Proof, error) {
+	if len(s.Proof) == 0 {
+		return nil, ErrNilProof
+	}
+
+	return NewProofFromHexString(s.Proof)
+}
+
+// SwapStore persists SwapState's between restarts
+type SwapStore interface {
+	Save(state *SwapState) error
+	Load(id string) (*SwapState, error)
+	List() ([]*SwapState, error)
+}
+
+type fileSwapStore struct {
+	m   sync.Mutex
+	dir string
+}
+
+// returns SwapStore which keeps every swap in a separate json file of passed directory
+func NewFileSwapStore(dir string) (SwapStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &fileSwapStore{dir: dir}, nil
+}
+
+func (s *fileSwapStore) Save(state *SwapState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	path, err := s.path(state.Id)
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	// write to a temporary file first, so a crash never leaves a half written state
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (s *fileSwapStore) Load(id string) (*SwapState, error) {
+	path, err := s.path(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrSwapNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := &SwapState{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (s *fileSwapStore) List() ([]*SwapState, error) {
+	files, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*SwapState, 0, len(files))
+	for _, f := range files {
+		state, err := s.Load(strings.TrimSuffix(filepath.Base(f), ".json"))
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+// path returns the file of the swap. Ids are secret hashes, so they can not point outside of the directory
+func (s *fileSwapStore) path(id string) (string, error) {
+	if _, err := StringToHash(id); err != nil {
+		return "", ErrInvalidSwapId
+	}
+
+	return filepath.Join(s.dir, id+".json"), nil
+}
+
+// returns a random Proof and a Secret of it for passed HashType
+func NewSwapProof(hashType HashType) (*Proof, *Secret, error) {
+	data := make([]byte, swapProofSize)
+	if _, err := rand.Read(data); err != nil {
+		return nil, nil, err
+	}
+
+	proof := NewProofFromBytes(data)
+	secret, err := proof.Secret(hashType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proof, secret, nil
+}
+
+// AtomicSwap drives hashed timelock swaps between a local and a remote chain.
+// Funds are locked on the local chain by LocalAccount and claimed on the remote chain by RemoteAccount.
+type AtomicSwap struct {
+	Local         *Client
+	Remote        *Client
+	LocalAccount  *Account
+	RemoteAccount *Account
+	Store         SwapStore
+	PollInterval  time.Duration
+	// SafetyMargin is the minimal time which should be left before the counterparty's lock expires
+	// to claim it, or between expiration of the participant's and the initiator's locks
+	SafetyMargin time.Duration
+	// LocalBlockTime and RemoteBlockTime are requested with Client.BlockGenerationTime when not set
+	LocalBlockTime  time.Duration
+	RemoteBlockTime time.Duration
+
+	m sync.Mutex
+}
+
+// returns AtomicSwap with default poll interval and safety margin
+func NewAtomicSwap(local, remote *Client, localAccount, remoteAccount *Account, store SwapStore) *AtomicSwap {
+	return &AtomicSwap{
+		Local:         local,
+		Remote:        remote,
+		LocalAccount:  localAccount,
+		RemoteAccount: remoteAccount,
+		Store:         store,
+		PollInterval:  DefaultSwapPollInterval,
+		SafetyMargin:  DefaultSwapSafetyMargin,
+	}
+}
+
+// Initiate creates a new swap with a fresh proof. Secret of the returned state should be passed to the counterparty.
+func (s *AtomicSwap) Initiate(terms *SwapTerms) (*SwapState, error) {
+	if terms == nil {
+		return nil, ErrInvalidSwapTerms
+	}
+
+	proof, secret, err := NewSwapProof(terms.HashType)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := newSwapState(SwapInitiator, secret, terms)
+	if err != nil {
+		return nil, err
+	}
+	state.Proof = proof.ProofString()
+
+	return state, s.Store.Save(state)
+}
+
+// Participate creates a new swap for a secret received from the initiator
+func (s *AtomicSwap) Participate(secret *Secret, terms *SwapTerms) (*SwapState, error) {
+	if secret == nil {
+		return nil, ErrNilSecret
+	}
+
+	state, err := newSwapState(SwapParticipant, secret, terms)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, s.Store.Save(state)
+}
+
+func newSwapState(role SwapRole, secret *Secret, terms *SwapTerms) (*SwapState, error) {
+	if terms == nil || terms.Mosaic == nil || terms.ExpectedMosaic == nil {
+		return nil, ErrInvalidSwapTerms
+	}
+
+	if terms.Recipient == nil {
+		return nil, ErrNilAddress
+	}
+
+	if terms.Duration <= 0 {
+		return nil, ErrInvalidSwapTerms
+	}
+
+	return &SwapState{
+		Id:              secret.HashString(),
+		Role:            role,
+		Stage:           SwapCreated,
+		HashType:        secret.Type,
+		Secret:          secret.HashString(),
+		AssetId:         terms.Mosaic.AssetId.Id(),
+		Amount:          terms.Mosaic.Amount,
+		Duration:        terms.Duration,
+		Recipient:       terms.Recipient.Address,
+		ExpectedAssetId: terms.ExpectedMosaic.AssetId.Id(),
+		ExpectedAmount:  terms.ExpectedMosaic.Amount,
+	}, nil
+}
+
+// Resume loads a persisted swap, so Run can continue it after a restart
+func (s *AtomicSwap) Resume(id string) (*SwapState, error) {
+	return s.Store.Load(id)
+}
+
+// Run steps the swap until it is claimed, refunded, failed or the context is done
+func (s *AtomicSwap) Run(ctx context.Context, state *SwapState) error {
+	for !state.Stage.IsFinal() {
+		stage := state.Stage
+		if err := s.Step(ctx, state); err != nil {
+			return err
+		}
+
+		if state.Stage != stage {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.PollInterval):
+		}
+	}
+
+	return nil
+}
+
+// Step makes at most one transition of the swap and persists the state if it was changed.
+// Nothing happens when the swap waits for the counterparty.
+func (s *AtomicSwap) Step(ctx context.Context, state *SwapState) error {
+	stage := state.Stage
+
+	var err error
+	switch state.Role {
+	case SwapInitiator:
+		err = s.stepInitiator(ctx, state)
+	case SwapParticipant:
+		err = s.stepParticipant(ctx, state)
+	default:
+		err = ErrInvalidSwapTerms
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if state.Stage != stage {
+		return s.Store.Save(state)
+	}
+
+	return nil
+}
+
+// initiator: created -> lockAnnounced -> locked -> counterpartyLocked -> claimAnnounced -> claimed
+func (s *AtomicSwap) stepInitiator(ctx context.Context, state *SwapState) error {
+	switch state.Stage {
+	case SwapCreated:
+		return s.lock(ctx, state)
+	case SwapLockAnnounced:
+		return s.confirmLock(ctx, state)
+	case SwapLocked:
+		if refunded, err := s.checkRefund(ctx, state); err != nil || refunded {
+			return err
+		}
+
+		lock, err := s.findCounterpartyLock(ctx, state)
+		if err != nil || lock == nil {
+			return err
+		}
+
+		left, err := s.remoteTimeLeft(ctx, lock.Height)
+		if err != nil {
+			return err
+		}
+		// not enough time to get the claim confirmed, the counterparty may take the funds back meanwhile
+		if left < s.SafetyMargin {
+			return nil
+		}
+
+		state.CounterpartyLockExpiry = lock.Height
+		state.Stage = SwapCounterpartyLocked
+		return nil
+	case SwapCounterpartyLocked:
+		return s.claim(ctx, state)
+	case SwapClaimAnnounced:
+		return s.confirmClaim(ctx, state)
+	}
+
+	return nil
+}
+
+// participant: created -> counterpartyLocked -> lockAnnounced -> locked -> proofRevealed -> claimAnnounced -> claimed
+func (s *AtomicSwap) stepParticipant(ctx context.Context, state *SwapState) error {
+	switch state.Stage {
+	case SwapCreated:
+		lock, err := s.findCounterpartyLock(ctx, state)
+		if err != nil || lock == nil {
+			return err
+		}
+
+		left, err := s.remoteTimeLeft(ctx, lock.Height)
+		if err != nil {
+			return err
+		}
+
+		localBlockTime, err := s.localBlockTime(ctx)
+		if err != nil {
+			return err
+		}
+
+		// own lock should expire before the initiator's one, so the initiator can't claim and refund at the same time
+		if left < time.Duration(state.Duration)*localBlockTime+s.SafetyMargin {
+			return ErrSwapUnsafeTiming
+		}
+
+		state.CounterpartyLockExpiry = lock.Height
+		state.Stage = SwapCounterpartyLocked
+		return nil
+	case SwapCounterpartyLocked:
+		return s.lock(ctx, state)
+	case SwapLockAnnounced:
+		return s.confirmLock(ctx, state)
+	case SwapLocked:
+		proof, err := s.findRevealedProof(ctx, state)
+		if err != nil {
+			return err
+		}
+
+		if proof == nil {
+			_, err := s.checkRefund(ctx, state)
+			return err
+		}
+
+		state.Proof = proof.ProofString()
+		state.Stage = SwapProofRevealed
+		return nil
+	case SwapProofRevealed:
+		return s.claim(ctx, state)
+	case SwapClaimAnnounced:
+		return s.confirmClaim(ctx, state)
+	}
+
+	return nil
+}
+
+func (s *AtomicSwap) lock(ctx context.Context, state *SwapState) error {
+	secret, err := state.secret()
+	if err != nil {
+		return err
+	}
+
+	assetId, err := NewAssetIdFromId(state.AssetId)
+	if err != nil {
+		return err
+	}
+
+	mosaic, err := NewMosaic(assetId, state.Amount)
+	if err != nil {
+		return err
+	}
+
+	recipient := NewAddress(state.Recipient, s.Local.NetworkType())
+
+	deadline, err := s.Local.NewDeadline(time.Hour)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.Local.NewSecretLockTransaction(deadline, mosaic, state.Duration, secret, recipient)
+	if err != nil {
+		return err
+	}
+
+	signed, err := s.LocalAccount.Sign(tx)
+	if err != nil {
+		return err
+	}
+
+	state.LockHash = signed.Hash.String()
+	state.LockPayload = signed.Payload
+	state.LockDeadline = deadline.UnixMilli()
+	state.Stage = SwapLockAnnounced
+	if err := s.Store.Save(state); err != nil {
+		return err
+	}
+
+	return s.confirmLock(ctx, state)
+}
+
+// confirmLock waits for the announced lock and moves the swap to locked stage.
+// The lock is signed again when its persisted payload is expired
+func (s *AtomicSwap) confirmLock(ctx context.Context, state *SwapState) error {
+	err := s.confirm(ctx, s.Local, SecretLock, state.LockHash, state.LockPayload, state.LockDeadline)
+	if err == errSwapPayloadExpired {
+		return s.lock(ctx, state)
+	}
+	if err != nil {
+		return err
+	}
+
+	secret, err := state.secret()
+	if err != nil {
+		return err
+	}
+
+	compositeHash, err := CalculateCompositeHash(&secret.Hash, NewAddress(state.Recipient, s.Local.NetworkType()))
+	if err != nil {
+		return err
+	}
+
+	info, err := s.Local.Lock.GetSecretLockInfo(ctx, compositeHash)
+	if err != nil {
+		return err
+	}
+
+	state.LockExpiry = info.Height
+	state.Stage = SwapLocked
+	return nil
+}
+
+func (s *AtomicSwap) claim(ctx context.Context, state *SwapState) error {
+	proof, err := state.proof()
+	if err != nil {
+		return err
+	}
+
+	deadline, err := s.Remote.NewDeadline(time.Hour)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.Remote.NewSecretProofTransaction(deadline, state.HashType, proof, s.RemoteAccount.Address)
+	if err != nil {
+		return err
+	}
+
+	signed, err := s.RemoteAccount.Sign(tx)
+	if err != nil {
+		return err
+	}
+
+	state.ClaimHash = signed.Hash.String()
+	state.ClaimPayload = signed.Payload
+	state.ClaimDeadline = deadline.UnixMilli()
+	state.Stage = SwapClaimAnnounced
+	if err := s.Store.Save(state); err != nil {
+		return err
+	}
+
+	return s.confirmClaim(ctx, state)
+}
+
+// confirmClaim waits for the announced proof and moves the swap to claimed stage.
+// The proof is signed again when its persisted payload is expired
+func (s *AtomicSwap) confirmClaim(ctx context.Context, state *SwapState) error {
+	err := s.confirm(ctx, s.Remote, SecretProof, state.ClaimHash, state.ClaimPayload, state.ClaimDeadline)
+	if err == errSwapPayloadExpired {
+		return s.claim(ctx, state)
+	}
+	if err != nil {
+		return err
+	}
+
+	state.Stage = SwapClaimed
+	return nil
+}
+
+// confirm looks up the status of the persisted transaction by its hash and announces the payload only
+// when the node doesn't know the transaction yet, then waits for its confirmation.
+// It returns errSwapPayloadExpired instead of announcing a payload after its deadline
+func (s *AtomicSwap) confirm(ctx context.Context, client *Client, entityType EntityType, hash, payload string, deadline int64) error {
+	h, err := StringToHash(hash)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Transaction.GetTransactionStatus(ctx, hash)
+	if IsNotFound(err) {
+		if deadline != 0 && !client.TimeSync.Now().Before(time.UnixMilli(deadline)) {
+			return errSwapPayloadExpired
+		}
+
+		_, err = client.Transaction.Announce(ctx, &SignedTransaction{EntityType: entityType, Payload: payload, Hash: h})
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.waitForConfirmation(ctx, client, h)
+}
+
+// checkRefund moves the swap to refunded stage when own lock is expired. The chain returns expired funds by itself.
+func (s *AtomicSwap) checkRefund(ctx context.Context, state *SwapState) (bool, error) {
+	height, err := s.Local.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if height < state.LockExpiry {
+		return false, nil
+	}
+
+	state.Stage = SwapRefunded
+	return true, nil
+}
+
+// findCounterpartyLock returns unused lock of the secret on the remote chain which pays enough to RemoteAccount
+func (s *AtomicSwap) findCounterpartyLock(ctx context.Context, state *SwapState) (*SecretLockInfo, error) {
+	secret, err := state.secret()
+	if err != nil {
+		return nil, err
+	}
+
+	locks, err := s.Remote.Lock.GetSecretLockInfosBySecret(ctx, &secret.Hash)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := s.expectedMosaicId(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lock := range locks {
+		if lock.Status != Unused || lock.HashAlgorithm != state.HashType {
+			continue
+		}
+
+		if lock.Recipient == nil || lock.Recipient.Address != s.RemoteAccount.Address.Address {
+			continue
+		}
+
+		if lock.MosaicId == nil || lock.MosaicId.Id() != expected.Id() || lock.Amount < state.ExpectedAmount {
+			continue
+		}
+
+		return lock, nil
+	}
+
+	return nil, nil
+}
+
+func (s *AtomicSwap) expectedMosaicId(ctx context.Context, state *SwapState) (*MosaicId, error) {
+	assetId, err := NewAssetIdFromId(state.ExpectedAssetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if mosaicId, ok := assetId.(*MosaicId); ok {
+		return mosaicId, nil
+	}
+
+	info, err := s.Remote.Resolve.GetMosaicInfoByAssetId(ctx, assetId)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.MosaicId, nil
+}
+
+// findRevealedProof looks for SecretProofTransaction which used own lock on the local chain
+func (s *AtomicSwap) findRevealedProof(ctx context.Context, state *SwapState) (*Proof, error) {
+	secret, err := state.secret()
+	if err != nil {
+		return nil, err
+	}
+
+	for pageNumber := 1; ; pageNumber++ {
+		page, err := s.Local.Transaction.GetTransactionsByGroup(ctx, Confirmed, &TransactionsPageOptions{
+			RecipientAddress: state.Recipient,
+			Type:             []uint{uint(SecretProof)},
+			PaginationOrderingOptions: PaginationOrderingOptions{
+				PageSize:   swapProofsPageSize,
+				PageNumber: uint64(pageNumber),
+			},
+		})
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range page.Transactions {
+			proofTx, ok := tx.(*SecretProofTransaction)
+			if !ok || proofTx.HashType != state.HashType {
+				continue
+			}
+
+			proofSecret, err := proofTx.Proof.Secret(state.HashType)
+			if err != nil {
+				continue
+			}
+
+			if proofSecret.Hash == secret.Hash {
+				return proofTx.Proof, nil
+			}
+		}
+
+		if page.Pagination.PageNumber >= page.Pagination.TotalPages {
+			return nil, nil
+		}
+	}
+}
+
+func (s *AtomicSwap) waitForConfirmation(ctx context.Context, client *Client, hash *Hash) error {
+	return waitForConfirmation(ctx, client, hash, s.PollInterval)
+}
+
+func (s *AtomicSwap) remoteTimeLeft(ctx context.Context, expiry Height) (time.Duration, error) {
+	height, err := s.Remote.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if height >= expiry {
+		return 0, nil
+	}
+
+	blockTime, err := s.blockTime(ctx, s.Remote, &s.RemoteBlockTime)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(expiry-height) * blockTime, nil
+}
+
+func (s *AtomicSwap) localBlockTime(ctx context.Context) (time.Duration, error) {
+	return s.blockTime(ctx, s.Local, &s.LocalBlockTime)
+}
+
+// blockTime returns the block time of the chain, it is requested from the client once when it is not set
+func (s *AtomicSwap) blockTime(ctx context.Context, client *Client, blockTime *time.Duration) (time.Duration, error) {
+	s.m.Lock()
+	known := *blockTime
+	s.m.Unlock()
+
+	if known != 0 {
+		return known, nil
+	}
+
+	requested, err := client.BlockGenerationTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	s.m.Lock()
+	*blockTime = requested
+	s.m.Unlock()
+
+	return requested, nil
+}
@@ -0,0 +1,461 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSwapChain is a minimal stateful node which applies announced secret lock and secret proof transactions at once.
+// Like a real node it answers 404 for unknown transactions and secrets, the transaction is confirmed after its status is queried once
+type fakeSwapChain struct {
+	*sdkMock
+	m              sync.Mutex
+	generationHash *Hash
+	height         uint64
+	pending        map[string]bool
+	confirmed      map[string]bool
+	locks          []*secretLockInfoDTO
+	proofs         []*secretProofTransactionDTO
+}
+
+func newFakeSwapChain(t *testing.T, generationHash *Hash) *fakeSwapChain {
+	c := &fakeSwapChain{
+		sdkMock:        newSdkMock(0),
+		generationHash: generationHash,
+		height:         1,
+		pending:        make(map[string]bool),
+		confirmed:      make(map[string]bool),
+	}
+
+	c.AddHandler(transactionsRoute, func(w http.ResponseWriter, r *http.Request) {
+		signed := &struct {
+			Payload string `json:"payload"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(signed); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payload, err := hex.DecodeString(signed.Payload)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err := c.announce(payload); err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		writeFakeJson(w, map[string]string{"message": "packet 9 was pushed to the network via /transaction"})
+	})
+
+	c.AddHandler("/transactionStatus/", func(w http.ResponseWriter, r *http.Request) {
+		hash := strings.TrimPrefix(r.URL.Path, "/transactionStatus/")
+		c.m.Lock()
+		defer c.m.Unlock()
+		if !c.confirmed[hash] {
+			if c.pending[hash] {
+				delete(c.pending, hash)
+				c.confirmed[hash] = true
+			}
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeFakeJson(w, map[string]interface{}{
+			"group":    Confirmed,
+			"status":   "Success",
+			"hash":     hash,
+			"deadline": uint64ToArray(1),
+			"height":   uint64ToArray(c.height),
+		})
+	})
+
+	c.AddHandler("/lock/secret/", func(w http.ResponseWriter, r *http.Request) {
+		secret := strings.TrimPrefix(r.URL.Path, "/lock/secret/")
+		c.m.Lock()
+		defer c.m.Unlock()
+		locks := make([]*secretLockInfoDTO, 0)
+		for _, l := range c.locks {
+			if strings.EqualFold(string(l.Lock.Secret), secret) {
+				locks = append(locks, l)
+			}
+		}
+		if len(locks) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeFakeJson(w, locks)
+	})
+
+	c.AddHandler("/lock/compositeHash/", func(w http.ResponseWriter, r *http.Request) {
+		compositeHash := strings.TrimPrefix(r.URL.Path, "/lock/compositeHash/")
+		c.m.Lock()
+		defer c.m.Unlock()
+		for _, l := range c.locks {
+			if strings.EqualFold(string(l.Lock.CompositeHash), compositeHash) {
+				writeFakeJson(w, l)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	c.AddHandler(blockHeightRoute, func(w http.ResponseWriter, r *http.Request) {
+		c.m.Lock()
+		defer c.m.Unlock()
+		writeFakeJson(w, map[string]interface{}{"height": uint64ToArray(c.height)})
+	})
+
+	// serves a proof per page, so readers have to go through every page
+	c.AddHandler("/transactions/confirmed", func(w http.ResponseWriter, r *http.Request) {
+		c.m.Lock()
+		defer c.m.Unlock()
+		pageNumber, err := strconv.Atoi(r.URL.Query().Get("pageNumber"))
+		if err != nil || pageNumber < 1 {
+			pageNumber = 1
+		}
+		data := make([]*secretProofTransactionDTO, 0, 1)
+		if pageNumber <= len(c.proofs) {
+			data = append(data, c.proofs[pageNumber-1])
+		}
+		writeFakeJson(w, map[string]interface{}{
+			"data":       data,
+			"pagination": map[string]uint64{"totalEntries": uint64(len(c.proofs)), "pageNumber": uint64(pageNumber), "pageSize": 1, "totalPages": uint64(len(c.proofs))},
+		})
+	})
+
+	return c
+}
+
+func (c *fakeSwapChain) announce(payload []byte) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	hash, err := createTransactionHash(payload, c.generationHash)
+	if err != nil {
+		return err
+	}
+
+	signer := strings.ToUpper(hex.EncodeToString(payload[68:100]))
+	version := int64(binary.LittleEndian.Uint32(payload[100:104]))
+	body := payload[TransactionHeaderSize:]
+
+	switch EntityType(binary.LittleEndian.Uint16(payload[104:106])) {
+	case SecretLock:
+		secret := &Hash{}
+		copy(secret[:], body[25:57])
+		recipient, err := NewAddressFromBase32(hex.EncodeToString(body[57:82]))
+		if err != nil {
+			return err
+		}
+		compositeHash, err := CalculateCompositeHash(secret, recipient)
+		if err != nil {
+			return err
+		}
+
+		l := &secretLockInfoDTO{}
+		l.Lock.Account = signer
+		l.Lock.MosaicId = uint64ToArray(binary.LittleEndian.Uint64(body[0:8]))
+		l.Lock.Amount = uint64ToArray(binary.LittleEndian.Uint64(body[8:16]))
+		l.Lock.Height = uint64ToArray(c.height + binary.LittleEndian.Uint64(body[16:24]))
+		l.Lock.Status = Unused
+		l.Lock.HashAlgorithm = HashType(body[24])
+		l.Lock.Secret = hashDto(secret.String())
+		l.Lock.Recipient = strings.ToUpper(hex.EncodeToString(body[57:82]))
+		l.Lock.CompositeHash = hashDto(compositeHash.String())
+		c.locks = append(c.locks, l)
+	case SecretProof:
+		hashType := HashType(body[0])
+		secret := strings.ToUpper(hex.EncodeToString(body[1:33]))
+		recipient := strings.ToUpper(hex.EncodeToString(body[33:58]))
+		for _, l := range c.locks {
+			if strings.EqualFold(string(l.Lock.Secret), secret) && l.Lock.Recipient == recipient {
+				l.Lock.Status = Used
+			}
+		}
+
+		p := &secretProofTransactionDTO{}
+		p.Tx.Type = SecretProof
+		p.Tx.Version = version
+		p.Tx.Signer = signer
+		p.Tx.Signature = strings.ToUpper(hex.EncodeToString(payload[4:68]))
+		p.Tx.HashType = hashType
+		p.Tx.Proof = strings.ToUpper(hex.EncodeToString(body[60:]))
+		p.Tx.Recipient = recipient
+		p.TDto.Height = uint64ToArray(c.height)
+		p.TDto.TransactionHash = hashDto(hash.String())
+		c.proofs = append(c.proofs, p)
+	}
+
+	c.pending[hash.String()] = true
+	c.height++
+	return nil
+}
+
+func (c *fakeSwapChain) mine(blocks uint64) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.height += blocks
+}
+
+func (c *fakeSwapChain) lockCount() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return len(c.locks)
+}
+
+func (c *fakeSwapChain) lockStatuses() []LockStatusType {
+	c.m.Lock()
+	defer c.m.Unlock()
+	statuses := make([]LockStatusType, len(c.locks))
+	for i, l := range c.locks {
+		statuses[i] = l.Lock.Status
+	}
+	return statuses
+}
+
+func writeFakeJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type swapFixture struct {
+	chainA, chainB *fakeSwapChain
+	alice, bob     *AtomicSwap
+	aliceTerms     *SwapTerms
+	bobTerms       *SwapTerms
+}
+
+func newSwapFixture(t *testing.T) *swapFixture {
+	f := &swapFixture{
+		chainA: newFakeSwapChain(t, &Hash{1}),
+		chainB: newFakeSwapChain(t, &Hash{2}),
+	}
+
+	clientA, err := f.chainA.getClientByNetworkType(MijinTest)
+	require.NoError(t, err)
+	clientB, err := f.chainB.getClientByNetworkType(MijinTest)
+	require.NoError(t, err)
+	markSynced(clientA)
+	markSynced(clientB)
+
+	newAccount := func(generationHash *Hash) *Account {
+		acc, err := NewAccount(MijinTest, generationHash)
+		require.NoError(t, err)
+		return acc
+	}
+	aliceA, aliceB := newAccount(f.chainA.generationHash), newAccount(f.chainB.generationHash)
+	bobA, bobB := newAccount(f.chainA.generationHash), newAccount(f.chainB.generationHash)
+
+	newSwap := func(local, remote *Client, localAccount, remoteAccount *Account) *AtomicSwap {
+		store, err := NewFileSwapStore(t.TempDir())
+		require.NoError(t, err)
+		s := NewAtomicSwap(local, remote, localAccount, remoteAccount, store)
+		s.PollInterval = time.Millisecond * 10
+		s.SafetyMargin = time.Second * 10
+		s.LocalBlockTime = time.Second
+		s.RemoteBlockTime = time.Second
+		return s
+	}
+	f.alice = newSwap(clientA, clientB, aliceA, aliceB)
+	f.bob = newSwap(clientB, clientA, bobB, bobA)
+
+	mosaicA, err := NewMosaic(newMosaicIdPanic(0x0DC67FBE1CAD29E3), 100)
+	require.NoError(t, err)
+	mosaicB, err := NewMosaic(newMosaicIdPanic(0x26514E2A1EF33824), 50)
+	require.NoError(t, err)
+
+	f.aliceTerms = &SwapTerms{HashType: SHA3_256, Mosaic: mosaicA, Duration: 200, Recipient: bobA.Address, ExpectedMosaic: mosaicB}
+	f.bobTerms = &SwapTerms{HashType: SHA3_256, Mosaic: mosaicB, Duration: 50, Recipient: aliceB.Address, ExpectedMosaic: mosaicA}
+
+	return f
+}
+
+func TestAtomicSwap_Run(t *testing.T) {
+	f := newSwapFixture(t)
+
+	aliceState, err := f.alice.Initiate(f.aliceTerms)
+	require.NoError(t, err)
+
+	secret, err := aliceState.secret()
+	require.NoError(t, err)
+	bobState, err := f.bob.Participate(secret, f.bobTerms)
+	require.NoError(t, err)
+
+	// an unrelated proof for the same recipient is revealed first
+	decoy, _, err := NewSwapProof(SHA3_256)
+	require.NoError(t, err)
+	decoyTx, err := f.alice.Remote.NewSecretProofTransaction(NewDeadline(time.Hour), SHA3_256, decoy, f.alice.RemoteAccount.Address)
+	require.NoError(t, err)
+	signed, err := f.alice.RemoteAccount.Sign(decoyTx)
+	require.NoError(t, err)
+	_, err = f.alice.Remote.Transaction.Announce(ctx, signed)
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	var aliceErr, bobErr error
+	go func() {
+		defer wg.Done()
+		aliceErr = f.alice.Run(runCtx, aliceState)
+	}()
+	go func() {
+		defer wg.Done()
+		bobErr = f.bob.Run(runCtx, bobState)
+	}()
+	wg.Wait()
+
+	require.NoError(t, aliceErr)
+	require.NoError(t, bobErr)
+	assert.Equal(t, SwapClaimed, aliceState.Stage)
+	assert.Equal(t, SwapClaimed, bobState.Stage)
+	assert.Equal(t, aliceState.Proof, bobState.Proof)
+	assert.Equal(t, []LockStatusType{Used}, f.chainA.lockStatuses())
+	assert.Equal(t, []LockStatusType{Used}, f.chainB.lockStatuses())
+
+	stored, err := f.bob.Resume(bobState.Id)
+	require.NoError(t, err)
+	assert.Equal(t, bobState, stored)
+}
+
+func TestAtomicSwap_Refund(t *testing.T) {
+	f := newSwapFixture(t)
+
+	state, err := f.alice.Initiate(f.aliceTerms)
+	require.NoError(t, err)
+
+	require.NoError(t, f.alice.Step(ctx, state))
+	assert.Equal(t, SwapLocked, state.Stage)
+
+	// restart with the persisted state, the counterparty has never locked
+	state, err = f.alice.Resume(state.Id)
+	require.NoError(t, err)
+	require.NoError(t, f.alice.Step(ctx, state))
+	assert.Equal(t, SwapLocked, state.Stage)
+
+	f.chainA.mine(uint64(f.aliceTerms.Duration))
+	require.NoError(t, f.alice.Step(ctx, state))
+	assert.Equal(t, SwapRefunded, state.Stage)
+}
+
+func TestAtomicSwap_UnsafeTiming(t *testing.T) {
+	f := newSwapFixture(t)
+	f.bobTerms.Duration = 300
+
+	aliceState, err := f.alice.Initiate(f.aliceTerms)
+	require.NoError(t, err)
+	require.NoError(t, f.alice.Step(ctx, aliceState))
+
+	secret, err := aliceState.secret()
+	require.NoError(t, err)
+	bobState, err := f.bob.Participate(secret, f.bobTerms)
+	require.NoError(t, err)
+
+	assert.Equal(t, ErrSwapUnsafeTiming, f.bob.Step(ctx, bobState))
+	assert.Equal(t, SwapCreated, bobState.Stage)
+}
+
+var errSwapStopped = errors.New("swap is stopped")
+
+// stoppingSwapStore saves the state and fails when it reaches the stage, like a process stopped right after saving
+type stoppingSwapStore struct {
+	SwapStore
+	stage SwapStage
+}
+
+func (s *stoppingSwapStore) Save(state *SwapState) error {
+	if err := s.SwapStore.Save(state); err != nil {
+		return err
+	}
+
+	if state.Stage == s.stage {
+		return errSwapStopped
+	}
+
+	return nil
+}
+
+func TestAtomicSwap_ResumeAnnounced(t *testing.T) {
+	f := newSwapFixture(t)
+
+	state, err := f.alice.Initiate(f.aliceTerms)
+	require.NoError(t, err)
+
+	// the process stops after the lock is persisted as announced, but before the node gets it
+	store := f.alice.Store
+	f.alice.Store = &stoppingSwapStore{SwapStore: store, stage: SwapLockAnnounced}
+	assert.Equal(t, errSwapStopped, f.alice.Step(ctx, state))
+	f.alice.Store = store
+
+	state, err = f.alice.Resume(state.Id)
+	require.NoError(t, err)
+	assert.Equal(t, SwapLockAnnounced, state.Stage)
+	assert.NotEmpty(t, state.LockHash)
+	assert.Equal(t, 0, f.chainA.lockCount())
+
+	require.NoError(t, f.alice.Step(ctx, state))
+	assert.Equal(t, SwapLocked, state.Stage)
+	assert.Equal(t, 1, f.chainA.lockCount())
+
+	// the process stops after the lock is confirmed, but before the locked stage is persisted
+	state.Stage = SwapLockAnnounced
+	require.NoError(t, f.alice.Step(ctx, state))
+	assert.Equal(t, SwapLocked, state.Stage)
+	assert.Equal(t, 1, f.chainA.lockCount())
+}
+
+func TestAtomicSwap_ResumeExpired(t *testing.T) {
+	f := newSwapFixture(t)
+
+	state, err := f.alice.Initiate(f.aliceTerms)
+	require.NoError(t, err)
+
+	store := f.alice.Store
+	f.alice.Store = &stoppingSwapStore{SwapStore: store, stage: SwapLockAnnounced}
+	assert.Equal(t, errSwapStopped, f.alice.Step(ctx, state))
+	f.alice.Store = store
+
+	// the node never got the lock and its deadline has passed meanwhile
+	state, err = f.alice.Resume(state.Id)
+	require.NoError(t, err)
+	expiredHash := state.LockHash
+	state.LockDeadline = time.Now().Add(-time.Minute).UnixMilli()
+
+	require.NoError(t, f.alice.Step(ctx, state))
+	assert.Equal(t, SwapLocked, state.Stage)
+	assert.NotEqual(t, expiredHash, state.LockHash)
+	assert.Greater(t, state.LockDeadline, time.Now().UnixMilli())
+	assert.Equal(t, 1, f.chainA.lockCount())
+}
+
+func TestFileSwapStore_InvalidId(t *testing.T) {
+	store, err := NewFileSwapStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, id := range []string{"", "../swap", "../../" + strings.Repeat("0", 58)} {
+		_, err = store.Load(id)
+		assert.Equal(t, ErrInvalidSwapId, err, id)
+
+		assert.Equal(t, ErrInvalidSwapId, store.Save(&SwapState{Id: id}), id)
+	}
+
+	_, err = store.Load(strings.Repeat("0", 64))
+	assert.Equal(t, ErrSwapNotFound, err)
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package balance
+
+import (
+	"fmt"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+// Reason tells what changed the balance
+type Reason uint8
+
+const (
+	Transfer Reason = iota
+	Levy
+	Exchange
+	LiquidityProvider
+	TransactionFee
+	HarvestFee
+	// Reconcile is a difference between the tracked balance and the balance reported by the node.
+	// It covers transactions which are not derived, e.g. locks or removed exchange offers
+	Reconcile
+)
+
+func (r Reason) String() string {
+	switch r {
+	case Transfer:
+		return "Transfer"
+	case Levy:
+		return "Levy"
+	case Exchange:
+		return "Exchange"
+	case LiquidityProvider:
+		return "LiquidityProvider"
+	case TransactionFee:
+		return "TransactionFee"
+	case HarvestFee:
+		return "HarvestFee"
+	case Reconcile:
+		return "Reconcile"
+	default:
+		return "Unknown"
+	}
+}
+
+// Change is a change of the balance of Address in MosaicId
+type Change struct {
+	Address  *sdk.Address
+	MosaicId *sdk.MosaicId
+	Delta    int64
+	// Balance is the tracked balance after the change
+	Balance sdk.Amount
+	Reason  Reason
+	Height  sdk.Height
+	// Transaction is the confirmed transaction which changed the balance.
+	// It is nil for harvest fees and reconciliation
+	Transaction sdk.Transaction
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf(
+		`{ "Address": %s, "MosaicId": %s, "Delta": %d, "Balance": %s, "Reason": %s, "Height": %s }`,
+		c.Address,
+		c.MosaicId,
+		c.Delta,
+		c.Balance,
+		c.Reason,
+		c.Height,
+	)
+}
+
+type deltaKey struct {
+	mosaicId uint64
+	reason   Reason
+}
+
+// deltas sums balance changes of one transaction or block by mosaic and reason keeping their order
+type deltas struct {
+	keys   []deltaKey
+	values map[deltaKey]int64
+}
+
+func newDeltas() *deltas {
+	return &deltas{values: make(map[deltaKey]int64)}
+}
+
+func (d *deltas) add(mosaicId *sdk.MosaicId, reason Reason, delta int64) {
+	if delta == 0 {
+		return
+	}
+
+	key := deltaKey{mosaicId.Id(), reason}
+	if _, ok := d.values[key]; !ok {
+		d.keys = append(d.keys, key)
+	}
+
+	d.values[key] += delta
+}
@@ -0,0 +1,685 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// Package balance derives balance change events of an account from confirmed transactions and blocks.
+package balance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket"
+)
+
+const (
+	DefaultReconcileInterval = time.Minute
+	// fee multipliers of recent blocks are kept to calculate fees of their transactions
+	recentBlocks = 32
+	// the account is read at most maxAccountReads times while blocks keep being confirmed
+	maxAccountReads = 5
+)
+
+var (
+	// errUnknownDelta is returned for transactions which change the balance by an amount not known from the transaction
+	errUnknownDelta = errors.New("balance change is not known from the transaction")
+	// errHeightChanged is returned when every read of the account overlaps a new block
+	errHeightChanged = errors.New("chain height changed during every account read")
+)
+
+// Watcher streams balance changes of accounts. Changes are derived from confirmed transfers with levies,
+// exchange offers, liquidity provider transactions, transaction fees and harvested blocks.
+// The tracked balance is periodically reconciled with AccountService.GetAccountInfo,
+// differences are sent as Reconcile changes.
+type Watcher struct {
+	Client *sdk.Client
+	// Websocket should be listened by the caller
+	Websocket         websocket.CatapultClient
+	ReconcileInterval time.Duration
+	// CurrencyId is the mosaic of fees, exchange costs and liquidity provider deposits.
+	// The mosaic linked to prx.xpx is used when it is nil
+	CurrencyId *sdk.MosaicId
+}
+
+// returns Watcher with DefaultReconcileInterval
+func NewWatcher(client *sdk.Client, ws websocket.CatapultClient) *Watcher {
+	return &Watcher{
+		Client:            client,
+		Websocket:         ws,
+		ReconcileInterval: DefaultReconcileInterval,
+	}
+}
+
+// Watch sends balance changes of the address in passed mosaics or in all mosaics when none is passed.
+// The channel is closed when ctx is done or a websocket subscription is closed.
+func (w *Watcher) Watch(ctx context.Context, address *sdk.Address, mosaicIds ...*sdk.MosaicId) (<-chan *Change, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	txs, txsId, err := w.Websocket.NewConfirmedAddedSubscription(address)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, blocksId, err := w.Websocket.NewBlockSubscription()
+	if err != nil {
+		w.Websocket.ConfirmedAddedUnsubscribe(address, txsId)
+		return nil, err
+	}
+
+	unsubscribe := func() {
+		w.Websocket.ConfirmedAddedUnsubscribe(address, txsId)
+		w.Websocket.BlockUnsubscribe(blocksId)
+	}
+
+	a := newAccount(w, address, mosaicIds)
+
+	// the first reconciliation only sets the tracked balance
+	if _, err := a.reconcile(ctx); err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	changes := make(chan *Change)
+
+	go func() {
+		defer close(changes)
+		defer unsubscribe()
+
+		ticker := time.NewTicker(w.ReconcileInterval)
+		defer ticker.Stop()
+
+		for {
+			var pending []*Change
+
+			select {
+			case <-ctx.Done():
+				return
+			case tx, ok := <-txs:
+				if !ok {
+					return
+				}
+				pending = a.transaction(ctx, tx)
+			case block, ok := <-blocks:
+				if !ok {
+					return
+				}
+				pending = a.block(ctx, block)
+			case <-ticker.C:
+				a.dirty = true
+			}
+
+			if a.dirty {
+				// a failed reconciliation is retried on the next tick
+				reconciled, _ := a.reconcile(ctx)
+				pending = append(pending, reconciled...)
+			}
+
+			for _, change := range pending {
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+// account is the tracked state of a watched address
+type account struct {
+	w        *Watcher
+	address  *sdk.Address
+	mosaics  map[uint64]bool
+	balances map[uint64]sdk.Amount
+	linked   *sdk.PublicAccount
+	// reconciled is the chain height the last reconciliation was read at, its changes are already in balances
+	reconciled sdk.Height
+	// dirty means that some change was not derived and the balance should be reconciled at once
+	dirty bool
+	seen  map[string]sdk.Height
+
+	currencyId  *sdk.MosaicId
+	multipliers map[sdk.Height]uint32
+	levies      map[uint64]*sdk.MosaicLevy
+	mosaicIds   map[uint64]*sdk.MosaicId
+	addresses   map[uint64]*sdk.Address
+}
+
+func newAccount(w *Watcher, address *sdk.Address, mosaicIds []*sdk.MosaicId) *account {
+	a := &account{
+		w:           w,
+		address:     address,
+		mosaics:     make(map[uint64]bool, len(mosaicIds)),
+		balances:    make(map[uint64]sdk.Amount),
+		seen:        make(map[string]sdk.Height),
+		currencyId:  w.CurrencyId,
+		multipliers: make(map[sdk.Height]uint32),
+	}
+
+	for _, id := range mosaicIds {
+		a.mosaics[id.Id()] = true
+	}
+
+	a.resetCaches()
+
+	return a
+}
+
+func (a *account) resetCaches() {
+	a.levies = make(map[uint64]*sdk.MosaicLevy)
+	a.mosaicIds = make(map[uint64]*sdk.MosaicId)
+	a.addresses = make(map[uint64]*sdk.Address)
+}
+
+func (a *account) owns(address *sdk.Address) bool {
+	return address != nil && address.Address == a.address.Address
+}
+
+func (a *account) signs(tx sdk.Transaction) bool {
+	signer := tx.GetAbstractTransaction().Signer
+	return signer != nil && a.owns(signer.Address)
+}
+
+// reconcile replaces tracked balances with balances reported by the node and returns differences
+func (a *account) reconcile(ctx context.Context) ([]*Change, error) {
+	info, height, err := a.accountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actual := make(map[uint64]sdk.Amount)
+
+	a.linked = nil
+	if info != nil {
+		for _, m := range info.Mosaics {
+			actual[m.AssetId.Id()] += m.Amount
+		}
+
+		if info.AccountType == sdk.MainAccount {
+			a.linked = info.LinkedAccount
+		}
+	}
+
+	changes := make([]*Change, 0)
+	for id, amount := range actual {
+		if tracked := a.balances[id]; tracked != amount && a.watches(id) {
+			changes = append(changes, a.reconcileChange(id, int64(amount)-int64(tracked), amount, height))
+		}
+	}
+
+	for id, tracked := range a.balances {
+		if _, ok := actual[id]; !ok && tracked != 0 && a.watches(id) {
+			changes = append(changes, a.reconcileChange(id, -int64(tracked), 0, height))
+		}
+	}
+
+	a.balances = actual
+	a.reconciled = height
+	a.dirty = false
+	a.resetCaches()
+
+	for hash, h := range a.seen {
+		if h <= height {
+			delete(a.seen, hash)
+		}
+	}
+
+	return changes, nil
+}
+
+// accountInfo returns the account and the height it was read at. The height is read before and after
+// the account, a block confirmed in between would be counted twice, so the read is repeated up to maxAccountReads times
+func (a *account) accountInfo(ctx context.Context) (*sdk.AccountInfo, sdk.Height, error) {
+	height, err := a.w.Client.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := 0; i < maxAccountReads; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+
+		info, err := a.w.Client.Account.GetAccountInfo(ctx, a.address)
+		switch {
+		case sdk.IsNotFound(err):
+			info = nil
+		case err != nil:
+			return nil, 0, err
+		}
+
+		after, err := a.w.Client.Blockchain.GetBlockchainHeight(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if after == height {
+			return info, height, nil
+		}
+
+		height = after
+	}
+
+	return nil, 0, errHeightChanged
+}
+
+func (a *account) reconcileChange(id uint64, delta int64, balance sdk.Amount, height sdk.Height) *Change {
+	return &Change{
+		Address:  a.address,
+		MosaicId: newMosaicId(id),
+		Delta:    delta,
+		Balance:  balance,
+		Reason:   Reconcile,
+		Height:   height,
+	}
+}
+
+func (a *account) watches(id uint64) bool {
+	return len(a.mosaics) == 0 || a.mosaics[id]
+}
+
+// transaction returns balance changes made by the confirmed transaction
+func (a *account) transaction(ctx context.Context, tx sdk.Transaction) []*Change {
+	info := tx.GetAbstractTransaction()
+	if info.Height <= a.reconciled || info.TransactionHash == nil {
+		return nil
+	}
+
+	hash := info.TransactionHash.String()
+	if _, ok := a.seen[hash]; ok {
+		return nil
+	}
+	a.seen[hash] = info.Height
+
+	d := newDeltas()
+	if err := a.derive(ctx, tx, d); err != nil {
+		a.dirty = true
+		return nil
+	}
+
+	return a.apply(d, info.Height, tx)
+}
+
+func (a *account) derive(ctx context.Context, tx sdk.Transaction, d *deltas) error {
+	if a.signs(tx) {
+		if err := a.fee(ctx, tx, d); err != nil {
+			return err
+		}
+	}
+
+	if aggregate, ok := tx.(*sdk.AggregateTransaction); ok {
+		for _, inner := range aggregate.InnerTransactions {
+			if err := a.deriveInner(ctx, inner, d); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return a.deriveInner(ctx, tx, d)
+}
+
+func (a *account) deriveInner(ctx context.Context, tx sdk.Transaction, d *deltas) error {
+	switch tx := tx.(type) {
+	case *sdk.TransferTransaction:
+		return a.transfer(ctx, tx, d)
+	case *sdk.AddExchangeOfferTransaction:
+		return a.addExchangeOffer(ctx, tx, d)
+	case *sdk.ExchangeOfferTransaction:
+		return a.exchangeOffer(ctx, tx, d)
+	case *sdk.RemoveExchangeOfferTransaction:
+		// the rest of the offer is returned to the owner
+		if a.signs(tx) {
+			return errUnknownDelta
+		}
+	case *sdk.CreateLiquidityProviderTransaction:
+		if a.signs(tx) {
+			currencyId, err := a.currency(ctx)
+			if err != nil {
+				return err
+			}
+			d.add(currencyId, LiquidityProvider, -int64(tx.CurrencyDeposit))
+		}
+	case *sdk.ManualRateChangeTransaction:
+		// balances of the provider are changed by the owner
+		return errUnknownDelta
+	}
+
+	return nil
+}
+
+func (a *account) transfer(ctx context.Context, tx *sdk.TransferTransaction, d *deltas) error {
+	recipient, err := a.resolveAddress(ctx, tx.Recipient)
+	if err != nil {
+		return err
+	}
+
+	signs, receives := a.signs(tx), a.owns(recipient)
+
+	for _, m := range tx.Mosaics {
+		mosaicId, err := a.resolveMosaic(ctx, m.AssetId)
+		if err != nil {
+			return err
+		}
+
+		if signs {
+			d.add(mosaicId, Transfer, -int64(m.Amount))
+		}
+
+		if receives {
+			d.add(mosaicId, Transfer, int64(m.Amount))
+		}
+
+		levy, err := a.levy(ctx, mosaicId)
+		if err != nil {
+			return err
+		}
+
+		if levy == nil || m.Amount == 0 {
+			continue
+		}
+
+		levyMosaicId := levy.MosaicId
+		if levyMosaicId == nil {
+			levyMosaicId = mosaicId
+		}
+
+		fee := int64(levy.Amount(m.Amount))
+		if signs {
+			d.add(levyMosaicId, Levy, -fee)
+		}
+
+		if a.owns(levy.Recipient) {
+			d.add(levyMosaicId, Levy, fee)
+		}
+	}
+
+	return nil
+}
+
+func (a *account) addExchangeOffer(ctx context.Context, tx *sdk.AddExchangeOfferTransaction, d *deltas) error {
+	if !a.signs(tx) {
+		return nil
+	}
+
+	for _, offer := range tx.Offers {
+		mosaicId, currencyId, err := a.offerMosaics(ctx, &offer.Offer)
+		if err != nil {
+			return err
+		}
+
+		// mosaics of sell offers and currency of buy offers are deposited
+		switch offer.Type {
+		case sdk.SellOffer:
+			d.add(mosaicId, Exchange, -int64(offer.Mosaic.Amount))
+		case sdk.BuyOffer:
+			d.add(currencyId, Exchange, -int64(offer.Cost))
+		}
+	}
+
+	return nil
+}
+
+func (a *account) exchangeOffer(ctx context.Context, tx *sdk.ExchangeOfferTransaction, d *deltas) error {
+	signs := a.signs(tx)
+
+	for _, c := range tx.Confirmations {
+		owns := c.Owner != nil && a.owns(c.Owner.Address)
+		if !signs && !owns {
+			continue
+		}
+
+		mosaicId, currencyId, err := a.offerMosaics(ctx, &c.Offer)
+		if err != nil {
+			return err
+		}
+
+		amount, cost := int64(c.Mosaic.Amount), int64(c.Cost)
+
+		// the owner has deposited the offered side when the offer was added
+		switch c.Type {
+		case sdk.SellOffer:
+			if signs {
+				d.add(mosaicId, Exchange, amount)
+				d.add(currencyId, Exchange, -cost)
+			}
+			if owns {
+				d.add(currencyId, Exchange, cost)
+			}
+		case sdk.BuyOffer:
+			if signs {
+				d.add(mosaicId, Exchange, -amount)
+				d.add(currencyId, Exchange, cost)
+			}
+			if owns {
+				d.add(mosaicId, Exchange, amount)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *account) offerMosaics(ctx context.Context, offer *sdk.Offer) (*sdk.MosaicId, *sdk.MosaicId, error) {
+	mosaicId, err := a.resolveMosaic(ctx, offer.Mosaic.AssetId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currencyId, err := a.currency(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mosaicId, currencyId, nil
+}
+
+// fee adds the fee paid by the signer of the top level transaction
+func (a *account) fee(ctx context.Context, tx sdk.Transaction, d *deltas) error {
+	info := tx.GetAbstractTransaction()
+
+	multiplier, ok := a.multipliers[info.Height]
+	if !ok {
+		block, err := a.w.Client.Blockchain.GetBlockByHeight(ctx, info.Height)
+		if err != nil {
+			return err
+		}
+		multiplier = a.remember(block)
+	}
+
+	fee := sdk.Amount(uint64(multiplier) * uint64(tx.Size()))
+	if fee > info.MaxFee {
+		fee = info.MaxFee
+	}
+
+	if fee == 0 {
+		return nil
+	}
+
+	currencyId, err := a.currency(ctx)
+	if err != nil {
+		return err
+	}
+
+	d.add(currencyId, TransactionFee, -int64(fee))
+	return nil
+}
+
+func (a *account) remember(block *sdk.BlockInfo) uint32 {
+	a.multipliers[block.Height] = block.FeeMultiplier
+
+	for h := range a.multipliers {
+		if h+recentBlocks < block.Height {
+			delete(a.multipliers, h)
+		}
+	}
+
+	return block.FeeMultiplier
+}
+
+// block returns fees harvested by the account or its remote account in the block
+func (a *account) block(ctx context.Context, block *sdk.BlockInfo) []*Change {
+	a.remember(block)
+
+	if block.Height <= a.reconciled || block.TotalFee == 0 || block.Signer == nil {
+		return nil
+	}
+
+	harvested := a.owns(block.Signer.Address) ||
+		(a.linked != nil && a.linked.PublicKey == block.Signer.PublicKey)
+	if !harvested {
+		return nil
+	}
+
+	// the share of the beneficiary is set by the network config
+	if block.Beneficiary != nil && !a.owns(block.Beneficiary.Address) {
+		a.dirty = true
+		return nil
+	}
+
+	currencyId, err := a.currency(ctx)
+	if err != nil {
+		a.dirty = true
+		return nil
+	}
+
+	d := newDeltas()
+	d.add(currencyId, HarvestFee, int64(block.TotalFee))
+
+	return a.apply(d, block.Height, nil)
+}
+
+// apply adds deltas to tracked balances and returns changes of watched mosaics
+func (a *account) apply(d *deltas, height sdk.Height, tx sdk.Transaction) []*Change {
+	changes := make([]*Change, 0, len(d.keys))
+
+	for _, key := range d.keys {
+		delta := d.values[key]
+		if delta == 0 {
+			continue
+		}
+
+		balance := int64(a.balances[key.mosaicId]) + delta
+		if balance < 0 {
+			// some earlier change was missed
+			a.dirty = true
+			balance = 0
+		}
+		a.balances[key.mosaicId] = sdk.Amount(balance)
+
+		if !a.watches(key.mosaicId) {
+			continue
+		}
+
+		changes = append(changes, &Change{
+			Address:     a.address,
+			MosaicId:    newMosaicId(key.mosaicId),
+			Delta:       delta,
+			Balance:     sdk.Amount(balance),
+			Reason:      key.reason,
+			Height:      height,
+			Transaction: tx,
+		})
+	}
+
+	return changes
+}
+
+func (a *account) currency(ctx context.Context) (*sdk.MosaicId, error) {
+	if a.currencyId != nil {
+		return a.currencyId, nil
+	}
+
+	mosaicId, err := a.w.Client.Namespace.GetLinkedMosaicId(ctx, sdk.XpxNamespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	a.currencyId = mosaicId
+	return mosaicId, nil
+}
+
+func (a *account) resolveMosaic(ctx context.Context, assetId sdk.AssetId) (*sdk.MosaicId, error) {
+	if assetId == nil {
+		return nil, sdk.ErrNilAssetId
+	}
+
+	if mosaicId, ok := assetId.(*sdk.MosaicId); ok {
+		return mosaicId, nil
+	}
+
+	if mosaicId, ok := a.mosaicIds[assetId.Id()]; ok {
+		return mosaicId, nil
+	}
+
+	namespaceId, ok := assetId.(*sdk.NamespaceId)
+	if !ok {
+		return nil, sdk.ErrUnknownBlockchainType
+	}
+
+	mosaicId, err := a.w.Client.Namespace.GetLinkedMosaicId(ctx, namespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mosaicIds[assetId.Id()] = mosaicId
+	return mosaicId, nil
+}
+
+// resolveAddress returns the address linked to the namespace when address is an alias
+func (a *account) resolveAddress(ctx context.Context, address *sdk.Address) (*sdk.Address, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	namespaceId, err := sdk.NewNamespaceIdFromAddress(address)
+	if err != nil || namespaceId == nil {
+		return address, err
+	}
+
+	if resolved, ok := a.addresses[namespaceId.Id()]; ok {
+		return resolved, nil
+	}
+
+	resolved, err := a.w.Client.Namespace.GetLinkedAddress(ctx, namespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	a.addresses[namespaceId.Id()] = resolved
+	return resolved, nil
+}
+
+func (a *account) levy(ctx context.Context, mosaicId *sdk.MosaicId) (*sdk.MosaicLevy, error) {
+	if levy, ok := a.levies[mosaicId.Id()]; ok {
+		return levy, nil
+	}
+
+	levy, err := a.w.Client.Mosaic.GetMosaicLevy(ctx, mosaicId)
+	switch {
+	case sdk.IsNotFound(err):
+		levy = nil
+	case err != nil:
+		return nil, err
+	}
+
+	if levy != nil && levy.Type == sdk.LevyNone {
+		levy = nil
+	}
+
+	a.levies[mosaicId.Id()] = levy
+	return levy, nil
+}
+
+// newMosaicId wraps ids taken from the node, they never have the namespace bit
+func newMosaicId(id uint64) *sdk.MosaicId {
+	mosaicId, _ := sdk.NewMosaicId(id)
+	return mosaicId
+}
@@ -0,0 +1,316 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package balance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/mocks"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket"
+	"github.com/proximax-storage/go-xpx-chain-sdk/test/fakenode"
+)
+
+var ctx = context.Background()
+
+func newMosaicIdT(t *testing.T, id uint64) *sdk.MosaicId {
+	mosaicId, err := sdk.NewMosaicId(id)
+	require.NoError(t, err)
+	return mosaicId
+}
+
+func newAccountT(t *testing.T, client *sdk.Client) *sdk.Account {
+	acc, err := client.NewAccount()
+	require.NoError(t, err)
+	return acc
+}
+
+func receive(t *testing.T, changes <-chan *Change) *Change {
+	select {
+	case c, ok := <-changes:
+		require.True(t, ok, "changes are closed")
+		return c
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "no balance change")
+		return nil
+	}
+}
+
+func TestWatcher_Watch(t *testing.T) {
+	node := fakenode.New(fakenode.Config{})
+	defer node.Close()
+
+	config, err := sdk.NewConfig(ctx, []string{node.URL()})
+	require.NoError(t, err)
+	client := sdk.NewClient(nil, config)
+
+	ws, err := websocket.NewClient(config)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go ws.Listen(runCtx)
+
+	owner, recipient := newAccountT(t, client), newAccountT(t, client)
+	mosaicId := newMosaicIdT(t, 0x0DC67FBE1CAD29E3)
+	node.AddMosaic(owner.PublicAccount, mosaicId, 1000, sdk.NewMosaicProperties(true, true, 0, 0))
+
+	w := NewWatcher(client, ws)
+	w.ReconcileInterval = time.Millisecond * 100
+
+	ownerChanges, err := w.Watch(runCtx, owner.Address, mosaicId)
+	require.NoError(t, err)
+	recipientChanges, err := w.Watch(runCtx, recipient.Address)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return node.HasSubscribers("confirmedAdded/"+owner.Address.Address) &&
+			node.HasSubscribers("confirmedAdded/"+recipient.Address.Address)
+	}, time.Second*5, time.Millisecond*10)
+
+	mosaic, err := sdk.NewMosaic(mosaicId, 100)
+	require.NoError(t, err)
+	tx, err := client.NewTransferTransaction(sdk.NewDeadline(time.Hour), recipient.Address, []*sdk.Mosaic{mosaic}, sdk.NewPlainMessage(""))
+	require.NoError(t, err)
+	signed, err := owner.Sign(tx)
+	require.NoError(t, err)
+	_, err = client.Transaction.Announce(ctx, signed)
+	require.NoError(t, err)
+	height := node.GenerateBlock()
+
+	c := receive(t, ownerChanges)
+	assert.Equal(t, Transfer, c.Reason)
+	assert.Equal(t, int64(-100), c.Delta)
+	assert.Equal(t, sdk.Amount(900), c.Balance)
+	assert.Equal(t, height, c.Height)
+	assert.Equal(t, mosaicId.Id(), c.MosaicId.Id())
+	require.NotNil(t, c.Transaction)
+	assert.Equal(t, signed.Hash.String(), c.Transaction.GetAbstractTransaction().TransactionHash.String())
+
+	c = receive(t, recipientChanges)
+	assert.Equal(t, Transfer, c.Reason)
+	assert.Equal(t, int64(100), c.Delta)
+	assert.Equal(t, sdk.Amount(100), c.Balance)
+
+	// the node changed the balance without a derived transaction
+	require.NoError(t, node.AddAccount(owner.PublicAccount, mosaic))
+
+	c = receive(t, ownerChanges)
+	assert.Equal(t, Reconcile, c.Reason)
+	assert.Equal(t, int64(100), c.Delta)
+	assert.Equal(t, sdk.Amount(1000), c.Balance)
+	assert.Nil(t, c.Transaction)
+
+	cancel()
+	for range ownerChanges {
+	}
+}
+
+func TestAccount_ReconcileDuringBlock(t *testing.T) {
+	mosaicId := newMosaicIdT(t, 0x0DC67FBE1CAD29E3)
+	mosaic, err := sdk.NewMosaic(mosaicId, 100)
+	require.NoError(t, err)
+	address := publicAccountT(t).Address
+
+	// a block is confirmed while the account is read, so the account is read again at the new height
+	blockchainService := mocks.NewBlockchainService(t)
+	blockchainService.On("GetBlockchainHeight", mock.Anything).Return(sdk.Height(10), nil).Once()
+	blockchainService.On("GetBlockchainHeight", mock.Anything).Return(sdk.Height(11), nil).Twice()
+	accountService := mocks.NewAccountService(t)
+	accountService.On("GetAccountInfo", mock.Anything, address).Return(&sdk.AccountInfo{Mosaics: []*sdk.Mosaic{mosaic}}, nil).Twice()
+
+	w := &Watcher{Client: &sdk.Client{Blockchain: blockchainService, Account: accountService}}
+	a := newAccount(w, address, nil)
+
+	changes, err := a.reconcile(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, sdk.Height(11), changes[0].Height)
+	assert.Equal(t, sdk.Height(11), a.reconciled)
+	assert.Equal(t, sdk.Amount(100), a.balances[mosaicId.Id()])
+}
+
+func TestAccount_ReconcileHeightChanges(t *testing.T) {
+	address := publicAccountT(t).Address
+
+	height := sdk.Height(10)
+	blockchainService := mocks.NewBlockchainService(t)
+	blockchainService.On("GetBlockchainHeight", mock.Anything).Return(func(context.Context) sdk.Height {
+		height++
+		return height
+	}, nil).Times(maxAccountReads + 1)
+	accountService := mocks.NewAccountService(t)
+	accountService.On("GetAccountInfo", mock.Anything, address).Return(&sdk.AccountInfo{}, nil).Times(maxAccountReads)
+
+	w := &Watcher{Client: &sdk.Client{Blockchain: blockchainService, Account: accountService}}
+	a := newAccount(w, address, nil)
+
+	_, err := a.reconcile(ctx)
+	assert.Equal(t, errHeightChanged, err)
+	assert.Equal(t, sdk.Height(0), a.reconciled)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	blockchainService.On("GetBlockchainHeight", mock.Anything).Return(sdk.Height(20), nil).Once()
+
+	_, err = a.reconcile(cancelled)
+	assert.Equal(t, context.Canceled, err)
+}
+
+// newDerivingAccount returns account which resolves nothing through the network except mosaic levies
+func newDerivingAccount(t *testing.T, address *sdk.Address, currencyId *sdk.MosaicId, levies map[uint64]*sdk.MosaicLevy) *account {
+	mosaicService := mocks.NewMosaicService(t)
+	mosaicService.On("GetMosaicLevy", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, id *sdk.MosaicId) *sdk.MosaicLevy { return levies[id.Id()] },
+		func(_ context.Context, id *sdk.MosaicId) error {
+			if _, ok := levies[id.Id()]; !ok {
+				return sdk.ErrResourceNotFound
+			}
+			return nil
+		},
+	).Maybe()
+
+	w := &Watcher{Client: &sdk.Client{Mosaic: mosaicService}, CurrencyId: currencyId}
+	return newAccount(w, address, nil)
+}
+
+func publicAccountT(t *testing.T) *sdk.PublicAccount {
+	acc, err := sdk.NewAccount(sdk.MijinTest, &sdk.Hash{})
+	require.NoError(t, err)
+	return acc.PublicAccount
+}
+
+func sums(changes []*Change) map[Reason]map[uint64]int64 {
+	s := make(map[Reason]map[uint64]int64)
+	for _, c := range changes {
+		if s[c.Reason] == nil {
+			s[c.Reason] = make(map[uint64]int64)
+		}
+		s[c.Reason][c.MosaicId.Id()] += c.Delta
+	}
+	return s
+}
+
+func confirmed(tx sdk.Transaction, signer *sdk.PublicAccount, height sdk.Height, hash byte) {
+	info := tx.GetAbstractTransaction()
+	info.Signer = signer
+	info.Height = height
+	info.TransactionHash = &sdk.Hash{hash}
+}
+
+func TestAccount_TransferWithLevy(t *testing.T) {
+	sender, recipient, levyRecipient := publicAccountT(t), publicAccountT(t), publicAccountT(t)
+	currencyId, mosaicId := newMosaicIdT(t, 1), newMosaicIdT(t, 2)
+
+	levies := map[uint64]*sdk.MosaicLevy{
+		mosaicId.Id(): {Type: sdk.LevyPercentileFee, Recipient: levyRecipient.Address, Fee: sdk.CreateMosaicLevyFeePercentile(1), MosaicId: currencyId},
+	}
+
+	tx, err := sdk.NewTransferTransaction(sdk.NewDeadline(time.Hour), recipient.Address, []*sdk.Mosaic{{AssetId: mosaicId, Amount: 1000}}, sdk.NewPlainMessage(""), sdk.MijinTest)
+	require.NoError(t, err)
+	confirmed(tx, sender, 2, 1)
+
+	a := newDerivingAccount(t, sender.Address, currencyId, levies)
+	a.multipliers[2] = 0
+	a.balances = map[uint64]sdk.Amount{currencyId.Id(): 100, mosaicId.Id(): 1000}
+	assert.Equal(t, map[Reason]map[uint64]int64{
+		Transfer: {mosaicId.Id(): -1000},
+		Levy:     {currencyId.Id(): -10},
+	}, sums(a.transaction(ctx, tx)))
+	assert.Empty(t, a.transaction(ctx, tx), "the same transaction is applied once")
+
+	a = newDerivingAccount(t, levyRecipient.Address, currencyId, levies)
+	assert.Equal(t, map[Reason]map[uint64]int64{
+		Levy: {currencyId.Id(): 10},
+	}, sums(a.transaction(ctx, tx)))
+
+	a = newDerivingAccount(t, recipient.Address, currencyId, levies)
+	assert.Equal(t, map[Reason]map[uint64]int64{
+		Transfer: {mosaicId.Id(): 1000},
+	}, sums(a.transaction(ctx, tx)))
+	assert.False(t, a.dirty)
+}
+
+func TestAccount_Exchange(t *testing.T) {
+	owner, taker := publicAccountT(t), publicAccountT(t)
+	currencyId, mosaicId := newMosaicIdT(t, 1), newMosaicIdT(t, 2)
+
+	add := &sdk.AddExchangeOfferTransaction{Offers: []*sdk.AddOffer{
+		{Offer: sdk.Offer{Type: sdk.SellOffer, Mosaic: &sdk.Mosaic{AssetId: mosaicId, Amount: 10}, Cost: 50}},
+		{Offer: sdk.Offer{Type: sdk.BuyOffer, Mosaic: &sdk.Mosaic{AssetId: mosaicId, Amount: 20}, Cost: 80}},
+	}}
+	confirmed(add, owner, 2, 1)
+
+	exchange := &sdk.ExchangeOfferTransaction{Confirmations: []*sdk.ExchangeConfirmation{
+		{Offer: sdk.Offer{Type: sdk.SellOffer, Mosaic: &sdk.Mosaic{AssetId: mosaicId, Amount: 10}, Cost: 50}, Owner: owner},
+		{Offer: sdk.Offer{Type: sdk.BuyOffer, Mosaic: &sdk.Mosaic{AssetId: mosaicId, Amount: 5}, Cost: 20}, Owner: owner},
+	}}
+	confirmed(exchange, taker, 3, 2)
+
+	a := newDerivingAccount(t, owner.Address, currencyId, nil)
+	a.multipliers[2], a.multipliers[3] = 0, 0
+	a.balances = map[uint64]sdk.Amount{currencyId.Id(): 1000, mosaicId.Id(): 1000}
+	assert.Equal(t, map[Reason]map[uint64]int64{
+		Exchange: {mosaicId.Id(): -10, currencyId.Id(): -80},
+	}, sums(a.transaction(ctx, add)))
+	assert.Equal(t, map[Reason]map[uint64]int64{
+		Exchange: {mosaicId.Id(): 5, currencyId.Id(): 50},
+	}, sums(a.transaction(ctx, exchange)))
+
+	a = newDerivingAccount(t, taker.Address, currencyId, nil)
+	a.multipliers[3] = 0
+	a.balances = map[uint64]sdk.Amount{currencyId.Id(): 1000, mosaicId.Id(): 1000}
+	assert.Equal(t, map[Reason]map[uint64]int64{
+		Exchange: {mosaicId.Id(): 5, currencyId.Id(): -30},
+	}, sums(a.transaction(ctx, exchange)))
+
+	remove := &sdk.RemoveExchangeOfferTransaction{Offers: []*sdk.RemoveOffer{{Type: sdk.SellOffer, AssetId: mosaicId}}}
+	confirmed(remove, owner, 4, 3)
+
+	a = newDerivingAccount(t, owner.Address, currencyId, nil)
+	a.multipliers[4] = 0
+	assert.Empty(t, a.transaction(ctx, remove))
+	assert.True(t, a.dirty, "removed offers are reconciled")
+}
+
+func TestAccount_Fees(t *testing.T) {
+	signer, harvester := publicAccountT(t), publicAccountT(t)
+	currencyId, mosaicId := newMosaicIdT(t, 1), newMosaicIdT(t, 2)
+
+	tx, err := sdk.NewTransferTransaction(sdk.NewDeadline(time.Hour), harvester.Address, []*sdk.Mosaic{{AssetId: mosaicId, Amount: 1}}, sdk.NewPlainMessage(""), sdk.MijinTest)
+	require.NoError(t, err)
+	tx.MaxFee = 1000000
+	confirmed(tx, signer, 2, 1)
+
+	a := newDerivingAccount(t, signer.Address, currencyId, nil)
+	a.balances = map[uint64]sdk.Amount{currencyId.Id(): 1000000, mosaicId.Id(): 1}
+	a.remember(&sdk.BlockInfo{Height: 2, FeeMultiplier: 10})
+	assert.Equal(t, map[Reason]map[uint64]int64{
+		TransactionFee: {currencyId.Id(): -int64(10 * tx.Size())},
+		Transfer:       {mosaicId.Id(): -1},
+	}, sums(a.transaction(ctx, tx)))
+
+	a = newDerivingAccount(t, harvester.Address, currencyId, nil)
+	changes := a.block(ctx, &sdk.BlockInfo{Height: 3, Signer: harvester, TotalFee: 42})
+	require.Len(t, changes, 1)
+	assert.Equal(t, HarvestFee, changes[0].Reason)
+	assert.Equal(t, int64(42), changes[0].Delta)
+	assert.Equal(t, sdk.Amount(42), changes[0].Balance)
+	assert.Nil(t, changes[0].Transaction)
+
+	// delegated harvesting signs blocks by the linked remote account
+	remote := publicAccountT(t)
+	a.linked = remote
+	changes = a.block(ctx, &sdk.BlockInfo{Height: 4, Signer: remote, TotalFee: 8})
+	require.Len(t, changes, 1)
+	assert.Equal(t, sdk.Amount(50), changes[0].Balance)
+}
@@ -12,10 +12,24 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type BlockchainService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name BlockchainService --output ../mocks --outpkg mocks
+
+// BlockchainService provides blocks, chain height, score and storage REST endpoints
+type BlockchainService interface {
+	// returns BlockInfo for passed block's height
+	GetBlockByHeight(ctx context.Context, height Height) (*BlockInfo, error)
+	// returns BlockInfo's for range block height - (block height + limit)
+	// Example: GetBlocksByHeightWithLimit(ctx, 1, 25) => [BlockInfo25, BlockInfo24, ..., BlockInfo1]
+	GetBlocksByHeightWithLimit(ctx context.Context, height Height, limit Amount) ([]*BlockInfo, error)
+	GetBlockchainHeight(ctx context.Context) (Height, error)
+	GetBlockchainScore(ctx context.Context) (*ChainScore, error)
+	GetBlockchainStorage(ctx context.Context) (*BlockchainStorageInfo, error)
+}
+
+type blockchainService service
 
 // returns BlockInfo for passed block's height
-func (b *BlockchainService) GetBlockByHeight(ctx context.Context, height Height) (*BlockInfo, error) {
+func (b *blockchainService) GetBlockByHeight(ctx context.Context, height Height) (*BlockInfo, error) {
 	if height == 0 {
 		return nil, ErrNilOrZeroHeight
 	}
@@ -38,7 +52,7 @@ func (b *BlockchainService) GetBlockByHeight(ctx context.Context, height Height)
 
 // returns BlockInfo's for range block height - (block height + limit)
 // Example: GetBlocksByHeightWithLimit(ctx, 1, 25) => [BlockInfo25, BlockInfo24, ..., BlockInfo1]
-func (b *BlockchainService) GetBlocksByHeightWithLimit(ctx context.Context, height Height, limit Amount) ([]*BlockInfo, error) {
+func (b *blockchainService) GetBlocksByHeightWithLimit(ctx context.Context, height Height, limit Amount) ([]*BlockInfo, error) {
 	if height == 0 {
 		return nil, ErrNilOrZeroHeight
 	}
@@ -63,7 +77,7 @@ func (b *BlockchainService) GetBlocksByHeightWithLimit(ctx context.Context, heig
 	return dtos.toStruct()
 }
 
-func (b *BlockchainService) GetBlockchainHeight(ctx context.Context) (Height, error) {
+func (b *blockchainService) GetBlockchainHeight(ctx context.Context) (Height, error) {
 	bh := &struct {
 		Height uint64DTO `json:"height"`
 	}{}
@@ -80,7 +94,7 @@ func (b *BlockchainService) GetBlockchainHeight(ctx context.Context) (Height, er
 	return bh.Height.toStruct(), nil
 }
 
-func (b *BlockchainService) GetBlockchainScore(ctx context.Context) (*ChainScore, error) {
+func (b *blockchainService) GetBlockchainScore(ctx context.Context) (*ChainScore, error) {
 	cs := &chainScoreDTO{}
 	resp, err := b.client.doNewRequest(ctx, http.MethodGet, blockScoreRoute, nil, &cs)
 	if err != nil {
@@ -94,7 +108,7 @@ func (b *BlockchainService) GetBlockchainScore(ctx context.Context) (*ChainScore
 	return cs.toStruct(), nil
 }
 
-func (b *BlockchainService) GetBlockchainStorage(ctx context.Context) (*BlockchainStorageInfo, error) {
+func (b *blockchainService) GetBlockchainStorage(ctx context.Context) (*BlockchainStorageInfo, error) {
 	bstorage := &BlockchainStorageInfo{}
 	resp, err := b.client.doNewRequest(ctx, http.MethodGet, blockStorageRoute, nil, &bstorage)
 	if err != nil {
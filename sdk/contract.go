@@ -9,9 +9,17 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type ContractService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name ContractService --output ../mocks --outpkg mocks
 
-func (ref *ContractService) GetContractsInfo(ctx context.Context, contractPubKeys ...string) ([]*ContractInfo, error) {
+// ContractService provides contract REST endpoints
+type ContractService interface {
+	GetContractsInfo(ctx context.Context, contractPubKeys ...string) ([]*ContractInfo, error)
+	GetContractsByAddress(ctx context.Context, address string) ([]*ContractInfo, error)
+}
+
+type contractService service
+
+func (ref *contractService) GetContractsInfo(ctx context.Context, contractPubKeys ...string) ([]*ContractInfo, error) {
 	if contractPubKeys == nil {
 		return nil, errors.New("contract public key should not be nil")
 	}
@@ -41,7 +49,7 @@ func (ref *ContractService) GetContractsInfo(ctx context.Context, contractPubKey
 	return infos, nil
 }
 
-func (ref *ContractService) GetContractsByAddress(ctx context.Context, address string) ([]*ContractInfo, error) {
+func (ref *contractService) GetContractsByAddress(ctx context.Context, address string) ([]*ContractInfo, error) {
 	if len(address) == 0 {
 		return nil, errors.New("address should not be blank")
 	}
@@ -64,6 +64,80 @@ var (
 	ErrInvalidNamespaceName = errors.New("namespace name is invalid")
 )
 
+// Multisig errors
+var (
+	ErrNilMultisigModification      = errors.New("multisig modification should not be nil")
+	ErrMultisigUnknownAccount       = errors.New("account is not a part of multisig graph")
+	ErrMultisigLoop                 = errors.New("multisig modification creates a loop")
+	ErrMultisigAlreadyCosignatory   = errors.New("account is already a cosignatory")
+	ErrMultisigNotCosignatory       = errors.New("account is not a cosignatory")
+	ErrMultisigMinSettingOutOfRange = errors.New("min approval or min removal is out of cosignatories range")
+	ErrMultisigUnsatisfiable        = errors.New("multisig account can't collect enough cosignatures")
+)
+
+// Atomic swap errors
+var (
+	ErrInvalidSwapTerms = errors.New("swap terms should have mosaics and positive duration")
+	ErrSwapNotFound     = errors.New("swap is not found")
+	ErrSwapUnsafeTiming = errors.New("counterparty lock expires too early to lock safely")
+	ErrInvalidSwapId    = errors.New("swap id should be a hex encoded secret hash")
+)
+
+// Harvesting errors
+var (
+	ErrHarvesterLinkedToOther = errors.New("main account is linked to another remote account")
+	ErrHarvesterNotRegistered = errors.New("harvester is not registered")
+)
+
+// Node discovery errors
+var (
+	ErrNoNodePeers            = errors.New("node peers are not available")
+	ErrGenerationHashMismatch = errors.New("generation hash of the node does not match")
+)
+
+// Finality errors
+var (
+	ErrNilFinalityPolicy        = errors.New("finality policy must not be nil")
+	ErrTransactionRolledBack    = errors.New("transaction was rolled back")
+	ErrTransactionExpired       = errors.New("transaction deadline expired before confirmation")
+	ErrFinalizationNotSupported = errors.New("node does not expose finalized height")
+)
+
+// Message errors
+var (
+	ErrMessageTypeRegistered              = errors.New("message type is already registered")
+	ErrUnknownMessageType                 = errors.New("message type has no registered codec")
+	ErrUnsupportedMessageValue            = errors.New("codec can not encode value of this type")
+	ErrMessageTooLarge                    = errors.New("message is larger than the network allows")
+	ErrInvalidJSONMessage                 = errors.New("json message payload is not valid json")
+	ErrInvalidHarvestingDelegationMessage = errors.New("harvesting delegation message is too short")
+	ErrInvalidSecureMessage               = errors.New("secure message payload has invalid length")
+	ErrSecureMessageCorrupted             = errors.New("secure message can not be decrypted with the shared key")
+)
+
+// Name resolution errors
+var (
+	ErrNamespaceNotLinked = errors.New("namespace is not linked to an address or a mosaic")
+	ErrInvalidAmount      = errors.New("amount is not a valid decimal for the mosaic divisibility")
+)
+
+// Transfer cost errors
+var (
+	ErrNilTransferTransaction = errors.New("transfer transaction should not be nil")
+)
+
+// Account restriction errors
+var (
+	ErrRestrictionPolicyConflict = errors.New("restriction policy both allows and blocks values of the same kind")
+	ErrRestrictionPolicyLockout  = errors.New("restriction policy blocks transactions needed to undo it")
+)
+
+// Time sync errors
+var (
+	ErrNodeTimeUnavailable = errors.New("node time is not available")
+	ErrDeadlineOutOfRange  = errors.New("deadline should be in the future and within the max transaction lifetime of the network")
+)
+
 // Blockchain errors
 var (
 	ErrNilOrZeroHeight = errors.New("block height should not be nil or zero")
@@ -74,6 +148,8 @@ var (
 var (
 	ErrNilSecret = errors.New("Secret should not be nil")
 	ErrNilProof  = errors.New("Proof should not be nil")
+
+	ErrInvalidHashLock = errors.New("lock funds transaction should lock a signed aggregate")
 )
 
 // plain errors
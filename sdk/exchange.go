@@ -12,12 +12,22 @@ import (
 	"net/http"
 )
 
-type ExchangeService struct {
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name ExchangeService --output ../mocks --outpkg mocks
+
+// ExchangeService provides exchange REST endpoints
+type ExchangeService interface {
+	GetAccountExchangeInfo(ctx context.Context, account *PublicAccount) (*UserExchangeInfo, error)
+	// Return offers with same operation type and mosaic id.
+	// Example: If you want to buy Storage units, you need to call GetExchangeOfferByAssetId(StorageMosaicId, SellOffer)
+	GetExchangeOfferByAssetId(ctx context.Context, assetId AssetId, offerType OfferType) ([]*OfferInfo, error)
+}
+
+type exchangeService struct {
 	*service
-	ResolveService *ResolverService
+	ResolveService ResolverService
 }
 
-func (e *ExchangeService) GetAccountExchangeInfo(ctx context.Context, account *PublicAccount) (*UserExchangeInfo, error) {
+func (e *exchangeService) GetAccountExchangeInfo(ctx context.Context, account *PublicAccount) (*UserExchangeInfo, error) {
 	if account == nil {
 		return nil, ErrNilAddress
 	}
@@ -40,7 +50,7 @@ func (e *ExchangeService) GetAccountExchangeInfo(ctx context.Context, account *P
 
 // Return offers with same operation type and mosaic id.
 // Example: If you want to buy Storage units, you need to call GetExchangeOfferByAssetId(StorageMosaicId, SellOffer)
-func (e *ExchangeService) GetExchangeOfferByAssetId(ctx context.Context, assetId AssetId, offerType OfferType) ([]*OfferInfo, error) {
+func (e *exchangeService) GetExchangeOfferByAssetId(ctx context.Context, assetId AssetId, offerType OfferType) ([]*OfferInfo, error) {
 	var mosaicId *MosaicId
 
 	switch assetId.Type() {
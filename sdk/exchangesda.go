@@ -13,12 +13,22 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type SdaExchangeService struct {
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name SdaExchangeService --output ../mocks --outpkg mocks
+
+// SdaExchangeService provides SDA-SDA exchange REST endpoints
+type SdaExchangeService interface {
+	GetAccountSdaExchangeInfo(ctx context.Context, account *PublicAccount) (*UserSdaExchangeInfo, error)
+	// Return offers with same mosaic id give or mosaic id get.
+	// offerType = give OR offerType = get ONLY
+	GetSdaExchangeOfferByAssetId(ctx context.Context, assetId AssetId, offerType string) ([]*SdaOfferBalance, error)
+}
+
+type sdaExchangeService struct {
 	*service
-	ResolveService *ResolverService
+	ResolveService ResolverService
 }
 
-func (e *SdaExchangeService) GetAccountSdaExchangeInfo(ctx context.Context, account *PublicAccount) (*UserSdaExchangeInfo, error) {
+func (e *sdaExchangeService) GetAccountSdaExchangeInfo(ctx context.Context, account *PublicAccount) (*UserSdaExchangeInfo, error) {
 	if account == nil {
 		return nil, ErrNilAddress
 	}
@@ -41,7 +51,7 @@ func (e *SdaExchangeService) GetAccountSdaExchangeInfo(ctx context.Context, acco
 
 // Return offers with same mosaic id give or mosaic id get.
 // offerType = give OR offerType = get ONLY
-func (e *SdaExchangeService) GetSdaExchangeOfferByAssetId(ctx context.Context, assetId AssetId, offerType string) ([]*SdaOfferBalance, error) {
+func (e *sdaExchangeService) GetSdaExchangeOfferByAssetId(ctx context.Context, assetId AssetId, offerType string) ([]*SdaOfferBalance, error) {
 	var mosaicId *MosaicId
 
 	switch assetId.Type() {
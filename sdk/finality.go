@@ -0,0 +1,182 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const DefaultFinalityPollInterval = time.Second * 5
+
+// FinalityPolicy tells when a confirmed transaction is considered final
+type FinalityPolicy struct {
+	// Depth is the number of blocks which must follow the block of the transaction
+	Depth uint64
+	// Duration is converted to the depth by Client.BlockGenerationTime. The greater depth is used
+	Duration time.Duration
+	// Finalized waits until the node finalizes the block of the transaction.
+	// No released REST version reports finalization, so WaitForFinality rejects it with ErrFinalizationNotSupported
+	Finalized bool
+	// PollInterval is the interval between checks. Zero means DefaultFinalityPollInterval
+	PollInterval time.Duration
+	// Blocks triggers a check on every new block, e.g. from the block websocket subscription.
+	// Polling is still used, so missed blocks only delay the result
+	Blocks <-chan *BlockInfo
+}
+
+// returns FinalityPolicy which waits for depth blocks after the block of the transaction
+func FixedDepth(depth uint64) *FinalityPolicy {
+	return &FinalityPolicy{Depth: depth}
+}
+
+// returns FinalityPolicy which waits for blocks generated during d after the block of the transaction
+func TimeDepth(d time.Duration) *FinalityPolicy {
+	return &FinalityPolicy{Duration: d}
+}
+
+// returns FinalityPolicy which waits for the finalized height of the node. It is not supported yet
+func FinalizedHeight() *FinalityPolicy {
+	return &FinalityPolicy{Finalized: true}
+}
+
+// Finality is the block in which the transaction became final
+type Finality struct {
+	Height    Height
+	BlockHash *Hash
+}
+
+func (f *Finality) String() string {
+	return fmt.Sprintf(`{ "Height": %s, "BlockHash": %s }`, f.Height, f.BlockHash)
+}
+
+// WaitForFinality waits until the transaction is confirmed and final by the policy. It returns
+// ErrTransactionRolledBack when the block of the transaction is dropped and ErrTransactionExpired
+// when the deadline passes before the confirmation
+func (txs *transactionService) WaitForFinality(ctx context.Context, hash *Hash, policy *FinalityPolicy) (*Finality, error) {
+	if hash == nil {
+		return nil, ErrNilHash
+	}
+
+	if policy == nil {
+		return nil, ErrNilFinalityPolicy
+	}
+
+	if policy.Finalized {
+		return nil, ErrFinalizationNotSupported
+	}
+
+	depth, err := txs.finalityDepth(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	pollInterval := policy.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultFinalityPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	blocks := policy.Blocks
+
+	var confirmed *Finality
+	for {
+		final, err := txs.checkFinality(ctx, hash, depth, &confirmed)
+		if err != nil || final != nil {
+			return final, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		case _, ok := <-blocks:
+			if !ok {
+				blocks = nil
+			}
+		}
+	}
+}
+
+func (txs *transactionService) finalityDepth(ctx context.Context, policy *FinalityPolicy) (uint64, error) {
+	depth := policy.Depth
+
+	if policy.Duration > 0 {
+		generationTime, err := txs.client.BlockGenerationTime(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if generationTime <= 0 {
+			return 0, ErrArgumentNotValid
+		}
+
+		byTime := uint64((policy.Duration + generationTime - 1) / generationTime)
+		if byTime > depth {
+			depth = byTime
+		}
+	}
+
+	return depth, nil
+}
+
+// checkFinality returns Finality when the transaction is final. The block of the first confirmation is kept
+// in confirmed, so the transaction is reported as rolled back if it moves to another block
+func (txs *transactionService) checkFinality(ctx context.Context, hash *Hash, depth uint64, confirmed **Finality) (*Finality, error) {
+	status, err := txs.GetTransactionStatus(ctx, hash.String())
+	if err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+
+	if status == nil || status.Group != Confirmed {
+		if *confirmed != nil {
+			return nil, fmt.Errorf("transaction %s at height %s: %w", hash, (*confirmed).Height, ErrTransactionRolledBack)
+		}
+
+		if status == nil {
+			return nil, nil
+		}
+
+		if err := status.Err(); err != nil {
+			if errors.Is(err, ErrCorePastDeadline) {
+				return nil, fmt.Errorf("transaction %s: %w", hash, ErrTransactionExpired)
+			}
+
+			return nil, fmt.Errorf("transaction %s is failed: %w", hash, err)
+		}
+
+		if status.Deadline != nil && time.Now().After(status.Deadline.Time) {
+			return nil, fmt.Errorf("transaction %s: %w", hash, ErrTransactionExpired)
+		}
+
+		return nil, nil
+	}
+
+	block, err := txs.BlockchainService.GetBlockByHeight(ctx, status.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	if *confirmed == nil {
+		*confirmed = &Finality{Height: status.Height, BlockHash: block.BlockHash}
+	} else if (*confirmed).Height != status.Height || !block.BlockHash.Equal((*confirmed).BlockHash) {
+		return nil, fmt.Errorf("transaction %s at height %s: %w", hash, (*confirmed).Height, ErrTransactionRolledBack)
+	}
+
+	height, err := txs.BlockchainService.GetBlockchainHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if uint64(height) >= uint64(status.Height)+depth {
+		return *confirmed, nil
+	}
+
+	return nil, nil
+}
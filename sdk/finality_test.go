@@ -0,0 +1,185 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFinalityChain serves the status of a single transaction and blocks of the current fork
+type fakeFinalityChain struct {
+	*sdkMock
+	m      sync.Mutex
+	fork   fakeForkChain
+	status map[string]interface{}
+	client *Client
+}
+
+func newFakeFinalityChain(t *testing.T, blocks int) *fakeFinalityChain {
+	c := &fakeFinalityChain{sdkMock: newSdkMock(0)}
+	c.fork.grow(blocks, 0)
+
+	c.AddHandler("/transactionStatus/", func(w http.ResponseWriter, r *http.Request) {
+		c.m.Lock()
+		defer c.m.Unlock()
+		if c.status == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeFakeJson(w, c.status)
+	})
+
+	c.client = c.getPublicTestClientUnsafe()
+	c.client.Transaction.(*transactionService).BlockchainService = c
+	t.Cleanup(c.Close)
+
+	return c
+}
+
+func (c *fakeFinalityChain) setStatus(group TransactionGroup, status string, height Height, deadline time.Duration) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.status = map[string]interface{}{
+		"group":    group,
+		"status":   status,
+		"hash":     (&Hash{1}).String(),
+		"deadline": uint64ToArray(uint64(NewDeadline(deadline).ToBlockchainTimestamp().baseInt64)),
+		"height":   uint64ToArray(uint64(height)),
+	}
+}
+
+func (c *fakeFinalityChain) GetBlockByHeight(ctx context.Context, height Height) (*BlockInfo, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.fork.GetBlockByHeight(ctx, height)
+}
+
+func (c *fakeFinalityChain) GetBlocksByHeightWithLimit(context.Context, Height, Amount) ([]*BlockInfo, error) {
+	return nil, ErrResourceNotFound
+}
+
+func (c *fakeFinalityChain) GetBlockchainHeight(context.Context) (Height, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return Height(len(c.fork.blocks)), nil
+}
+
+func (c *fakeFinalityChain) GetBlockchainScore(context.Context) (*ChainScore, error) {
+	return nil, ErrResourceNotFound
+}
+
+func (c *fakeFinalityChain) GetBlockchainStorage(context.Context) (*BlockchainStorageInfo, error) {
+	return nil, ErrResourceNotFound
+}
+
+func (c *fakeFinalityChain) block(height Height) *BlockInfo {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.fork.blocks[height-1]
+}
+
+func TestTransactionService_WaitForFinality_FixedDepth(t *testing.T) {
+	c := newFakeFinalityChain(t, 5)
+	c.setStatus(Confirmed, "Success", 3, time.Hour)
+
+	finality, err := c.client.Transaction.WaitForFinality(ctx, &Hash{1}, FixedDepth(2))
+	require.NoError(t, err)
+	assert.Equal(t, Height(3), finality.Height)
+	assert.Equal(t, c.block(3).BlockHash, finality.BlockHash)
+
+	timeout, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer cancel()
+
+	_, err = c.client.Transaction.WaitForFinality(timeout, &Hash{1}, &FinalityPolicy{Depth: 3, PollInterval: time.Millisecond * 10})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestTransactionService_WaitForFinality_Blocks(t *testing.T) {
+	c := newFakeFinalityChain(t, 3)
+	blocks := make(chan *BlockInfo)
+
+	go func() {
+		// the first receive means the transaction was checked while unknown
+		blocks <- c.block(3)
+		c.setStatus(Confirmed, "Success", 3, time.Hour)
+		c.m.Lock()
+		added := c.fork.grow(4, 0)
+		c.m.Unlock()
+		for _, b := range added {
+			blocks <- b
+		}
+	}()
+
+	finality, err := c.client.Transaction.WaitForFinality(ctx, &Hash{1}, &FinalityPolicy{Depth: 4, PollInterval: time.Hour, Blocks: blocks})
+	require.NoError(t, err)
+	assert.Equal(t, Height(3), finality.Height)
+	assert.Equal(t, c.block(3).BlockHash, finality.BlockHash)
+}
+
+func TestTransactionService_WaitForFinality_RolledBack(t *testing.T) {
+	c := newFakeFinalityChain(t, 3)
+	c.setStatus(Confirmed, "Success", 3, time.Hour)
+	blocks := make(chan *BlockInfo)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		blocks <- c.block(3)
+		c.m.Lock()
+		c.fork.rollback(1)
+		c.fork.grow(2, 1)
+		c.m.Unlock()
+		// the rollback may be noticed before the block is received
+		select {
+		case blocks <- c.block(4):
+		case <-done:
+		}
+	}()
+
+	_, err := c.client.Transaction.WaitForFinality(ctx, &Hash{1}, &FinalityPolicy{Depth: 10, PollInterval: time.Hour, Blocks: blocks})
+	assert.ErrorIs(t, err, ErrTransactionRolledBack)
+
+	c.setStatus(Unconfirmed, "Success", 0, time.Hour)
+	var confirmed = &Finality{Height: 3, BlockHash: c.block(3).BlockHash}
+	_, err = c.client.Transaction.(*transactionService).checkFinality(ctx, &Hash{1}, 10, &confirmed)
+	assert.ErrorIs(t, err, ErrTransactionRolledBack)
+}
+
+func TestTransactionService_WaitForFinality_Expired(t *testing.T) {
+	c := newFakeFinalityChain(t, 3)
+
+	c.setStatus(Unconfirmed, "Success", 0, -time.Minute)
+	_, err := c.client.Transaction.WaitForFinality(ctx, &Hash{1}, FixedDepth(1))
+	assert.ErrorIs(t, err, ErrTransactionExpired)
+
+	c.setStatus("failed", ErrCorePastDeadline.Status, 0, time.Hour)
+	_, err = c.client.Transaction.WaitForFinality(ctx, &Hash{1}, FixedDepth(1))
+	assert.ErrorIs(t, err, ErrTransactionExpired)
+
+	c.setStatus("failed", "Failure_Core_Insufficient_Balance", 0, time.Hour)
+	_, err = c.client.Transaction.WaitForFinality(ctx, &Hash{1}, FixedDepth(1))
+	assert.True(t, IsUserFixable(err))
+}
+
+func TestTransactionService_WaitForFinality_Finalized(t *testing.T) {
+	c := newFakeFinalityChain(t, 5)
+
+	// the policy is rejected before the unknown transaction is polled
+	timeout, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	_, err := c.client.Transaction.WaitForFinality(timeout, &Hash{1}, FinalizedHeight())
+	assert.Equal(t, ErrFinalizationNotSupported, err)
+
+	_, err = c.client.Transaction.WaitForFinality(timeout, &Hash{1}, &FinalityPolicy{Depth: 1, Finalized: true})
+	assert.Equal(t, ErrFinalizationNotSupported, err)
+}
@@ -0,0 +1,276 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultHarvestingPollInterval   = time.Second * 5
+	DefaultHarvestingReportInterval = time.Minute
+	harvestersPageSize              = 100
+)
+
+// HarvestingReport is a snapshot of a delegated harvester
+type HarvestingReport struct {
+	Time      time.Time
+	Harvester *Harvester
+	Err       error
+}
+
+// HarvestingManager enables, rotates and disables delegated harvesting of the main Account.
+// The main account links a remote account and registers its key as a harvester key,
+// so the private key of the main account never leaves the client.
+type HarvestingManager struct {
+	Client         *Client
+	Account        *Account
+	PollInterval   time.Duration
+	ReportInterval time.Duration
+}
+
+// returns HarvestingManager with default poll and report intervals
+func NewHarvestingManager(client *Client, account *Account) *HarvestingManager {
+	return &HarvestingManager{
+		Client:         client,
+		Account:        account,
+		PollInterval:   DefaultHarvestingPollInterval,
+		ReportInterval: DefaultHarvestingReportInterval,
+	}
+}
+
+// Enable links remote to the main account, registers it as a harvester and verifies that the node
+// knows the harvester. New remote account is created when remote is nil. Steps which are already done are skipped.
+// Remote is returned together with an error of any later step, so a created remote account is not lost.
+func (m *HarvestingManager) Enable(ctx context.Context, remote *Account) (*Account, *Harvester, error) {
+	if remote == nil {
+		var err error
+		remote, err = m.Client.NewAccount()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	linked, err := m.LinkedAccount(ctx)
+	if err != nil {
+		return remote, nil, err
+	}
+
+	switch {
+	case linked == nil:
+		if err := m.link(ctx, remote.PublicAccount, AccountLink); err != nil {
+			return remote, nil, err
+		}
+	case !samePublicKey(linked.PublicKey, remote.PublicAccount.PublicKey):
+		return remote, nil, ErrHarvesterLinkedToOther
+	}
+
+	harvester, err := m.Harvester(ctx, remote.PublicAccount)
+	if err != nil {
+		return remote, nil, err
+	}
+
+	if harvester == nil {
+		if err := m.register(ctx, remote.PublicAccount, AddHarvester); err != nil {
+			return remote, nil, err
+		}
+
+		harvester, err = m.Harvester(ctx, remote.PublicAccount)
+		if err != nil {
+			return remote, nil, err
+		}
+
+		if harvester == nil {
+			return remote, nil, ErrHarvesterNotRegistered
+		}
+	}
+
+	return remote, harvester, nil
+}
+
+// Disable deregisters remote as a harvester and unlinks it from the main account.
+// Steps which are already done are skipped.
+func (m *HarvestingManager) Disable(ctx context.Context, remote *PublicAccount) error {
+	if remote == nil {
+		return ErrNilAccount
+	}
+
+	harvester, err := m.Harvester(ctx, remote)
+	if err != nil {
+		return err
+	}
+
+	if harvester != nil {
+		if err := m.register(ctx, remote, RemoveHarvester); err != nil {
+			return err
+		}
+	}
+
+	linked, err := m.LinkedAccount(ctx)
+	if err != nil {
+		return err
+	}
+
+	if linked != nil && samePublicKey(linked.PublicKey, remote.PublicKey) {
+		return m.link(ctx, remote, AccountUnlink)
+	}
+
+	return nil
+}
+
+// Rotate disables harvesting with the old remote key and enables it with a new one.
+// New remote account is created when next is nil.
+func (m *HarvestingManager) Rotate(ctx context.Context, old *PublicAccount, next *Account) (*Account, *Harvester, error) {
+	if err := m.Disable(ctx, old); err != nil {
+		return nil, nil, err
+	}
+
+	return m.Enable(ctx, next)
+}
+
+// LinkedAccount returns the remote account linked to the main account or nil
+func (m *HarvestingManager) LinkedAccount(ctx context.Context) (*PublicAccount, error) {
+	info, err := m.Client.Account.GetAccountInfo(ctx, m.Account.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.AccountType != MainAccount || info.LinkedAccount == nil {
+		return nil, nil
+	}
+
+	return info.LinkedAccount, nil
+}
+
+// Harvester returns the harvester registered by the main account with the remote key or nil
+func (m *HarvestingManager) Harvester(ctx context.Context, remote *PublicAccount) (*Harvester, error) {
+	if remote == nil {
+		return nil, ErrNilAccount
+	}
+
+	harvester, err := m.Client.Account.GetAccountHarvesting(ctx, remote.Address)
+	if err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+
+	if harvester != nil && m.owns(harvester, remote) {
+		return harvester, nil
+	}
+
+	// not every node indexes harvesters by address, so look through all of them
+	for page := 1; ; page++ {
+		harvesters, err := m.Client.Account.GetHarvesters(ctx, &PaginationOrderingOptions{
+			PageSize:   harvestersPageSize,
+			PageNumber: uint64(page),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range harvesters.Harvesters {
+			if m.owns(h, remote) {
+				return h, nil
+			}
+		}
+
+		if harvesters.Pagination.PageNumber >= harvesters.Pagination.TotalPages {
+			return nil, nil
+		}
+	}
+}
+
+// Report returns the current state of the harvester
+func (m *HarvestingManager) Report(ctx context.Context, remote *PublicAccount) *HarvestingReport {
+	harvester, err := m.Harvester(ctx, remote)
+	if err == nil && harvester == nil {
+		err = ErrHarvesterNotRegistered
+	}
+
+	return &HarvestingReport{
+		Time:      time.Now(),
+		Harvester: harvester,
+		Err:       err,
+	}
+}
+
+// Monitor sends a report every ReportInterval until ctx is done
+func (m *HarvestingManager) Monitor(ctx context.Context, remote *PublicAccount) <-chan *HarvestingReport {
+	reports := make(chan *HarvestingReport)
+
+	go func() {
+		defer close(reports)
+
+		ticker := time.NewTicker(m.ReportInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case reports <- m.Report(ctx, remote):
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return reports
+}
+
+func (m *HarvestingManager) owns(h *Harvester, remote *PublicAccount) bool {
+	return samePublicKey(h.Key, remote.PublicKey) &&
+		(h.Owner == "" || samePublicKey(h.Owner, m.Account.PublicAccount.PublicKey))
+}
+
+func (m *HarvestingManager) link(ctx context.Context, remote *PublicAccount, action AccountLinkAction) error {
+	deadline, err := m.Client.NewDeadline(time.Hour)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.Client.NewAccountLinkTransaction(deadline, remote, action)
+	if err != nil {
+		return err
+	}
+
+	return m.announce(ctx, tx)
+}
+
+func (m *HarvestingManager) register(ctx context.Context, remote *PublicAccount, htt HarvesterTransactionType) error {
+	deadline, err := m.Client.NewDeadline(time.Hour)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.Client.NewHarvesterTransaction(deadline, htt, remote)
+	if err != nil {
+		return err
+	}
+
+	return m.announce(ctx, tx)
+}
+
+func (m *HarvestingManager) announce(ctx context.Context, tx Transaction) error {
+	signed, err := m.Account.Sign(tx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := m.Client.Transaction.Announce(ctx, signed); err != nil {
+		return err
+	}
+
+	return waitForConfirmation(ctx, m.Client, signed.Hash, m.PollInterval)
+}
+
+func samePublicKey(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
@@ -0,0 +1,290 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHarvestingChain is a minimal stateful node which applies account link and harvester transactions at once.
+// Like a real node it answers 404 for unknown transactions and harvesters, the transaction is confirmed after its status is queried once
+type fakeHarvestingChain struct {
+	*sdkMock
+	m              sync.Mutex
+	generationHash *Hash
+	owner          *PublicAccount
+	linked         string
+	harvesters     map[string]*harvesterDTO
+	announced      []EntityType
+	rejected       EntityType
+	pending        map[string]bool
+	confirmed      map[string]bool
+}
+
+func newFakeHarvestingChain(t *testing.T, owner *PublicAccount, generationHash *Hash) *fakeHarvestingChain {
+	c := &fakeHarvestingChain{
+		sdkMock:        newSdkMock(0),
+		generationHash: generationHash,
+		owner:          owner,
+		harvesters:     make(map[string]*harvesterDTO),
+		pending:        make(map[string]bool),
+		confirmed:      make(map[string]bool),
+	}
+
+	c.AddHandler(transactionsRoute, func(w http.ResponseWriter, r *http.Request) {
+		signed := &struct {
+			Payload string `json:"payload"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(signed); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payload, err := hex.DecodeString(signed.Payload)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err := c.announce(payload); err != nil {
+			if err != errFakeRejected {
+				t.Error(err)
+			}
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		writeFakeJson(w, map[string]string{"message": "packet 9 was pushed to the network via /transaction"})
+	})
+
+	c.AddHandler("/transactionStatus/", func(w http.ResponseWriter, r *http.Request) {
+		hash := strings.TrimPrefix(r.URL.Path, "/transactionStatus/")
+		c.m.Lock()
+		defer c.m.Unlock()
+		if !c.confirmed[hash] {
+			if c.pending[hash] {
+				delete(c.pending, hash)
+				c.confirmed[hash] = true
+			}
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeFakeJson(w, map[string]interface{}{
+			"group":    Confirmed,
+			"status":   "Success",
+			"hash":     hash,
+			"deadline": uint64ToArray(1),
+			"height":   uint64ToArray(1),
+		})
+	})
+
+	c.AddHandler("/account/", func(w http.ResponseWriter, r *http.Request) {
+		c.m.Lock()
+		defer c.m.Unlock()
+
+		if strings.HasSuffix(r.URL.Path, "/harvesting") {
+			harvesters := make([]*harvesterDTO, 0)
+			for _, h := range c.harvesters {
+				if strings.Contains(r.URL.Path, h.Harvester.Address) {
+					harvesters = append(harvesters, h)
+				}
+			}
+			if len(harvesters) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeFakeJson(w, harvesters)
+			return
+		}
+
+		info := &accountInfoDTO{}
+		info.Account.Address = fakeAddressHex(c.owner.Address)
+		info.Account.PublicKey = c.owner.PublicKey
+		info.Account.Mosaics = []*mosaicDTO{}
+		if c.linked != "" {
+			info.Account.AccountType = MainAccount
+			info.Account.LinkedAccountPublicKey = c.linked
+		}
+		writeFakeJson(w, info)
+	})
+
+	c.AddHandler(harvestersRoute, func(w http.ResponseWriter, r *http.Request) {
+		c.m.Lock()
+		defer c.m.Unlock()
+		page := &harvestersPageDTO{}
+		for _, h := range c.harvesters {
+			page.Harvesters = append(page.Harvesters, *h)
+		}
+		page.Pagination.PageNumber = 1
+		page.Pagination.TotalPages = 1
+		writeFakeJson(w, page)
+	})
+
+	return c
+}
+
+var errFakeRejected = errors.New("transaction is rejected")
+
+func fakeAddressHex(address *Address) string {
+	raw, _ := base32.StdEncoding.DecodeString(address.Address)
+	return strings.ToUpper(hex.EncodeToString(raw))
+}
+
+func (c *fakeHarvestingChain) announce(payload []byte) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	hash, err := createTransactionHash(payload, c.generationHash)
+	if err != nil {
+		return err
+	}
+
+	entityType := EntityType(binary.LittleEndian.Uint16(payload[104:106]))
+	if entityType == c.rejected {
+		return errFakeRejected
+	}
+
+	body := payload[TransactionHeaderSize:]
+	key := strings.ToUpper(hex.EncodeToString(body[0:32]))
+
+	switch entityType {
+	case LinkAccount:
+		if AccountLinkAction(body[32]) == AccountLink {
+			c.linked = key
+		} else {
+			c.linked = ""
+		}
+	case AddHarvesterEntityType:
+		remote, err := NewAccountFromPublicKey(key, c.owner.Address.Type)
+		if err != nil {
+			return err
+		}
+		h := &harvesterDTO{}
+		h.Harvester.Key = key
+		h.Harvester.Owner = c.owner.PublicKey
+		h.Harvester.Address = fakeAddressHex(remote.Address)
+		h.Harvester.CanHarvest = true
+		h.Harvester.EffectiveBalance = uint64ToArray(1000)
+		h.Harvester.LastSigningBlockHeight = uint64ToArray(42)
+		c.harvesters[key] = h
+	case RemoveHarvesterEntityType:
+		delete(c.harvesters, key)
+	}
+
+	c.announced = append(c.announced, entityType)
+	c.pending[hash.String()] = true
+	return nil
+}
+
+func (c *fakeHarvestingChain) announcedTypes() []EntityType {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return append([]EntityType(nil), c.announced...)
+}
+
+func newHarvestingFixture(t *testing.T) (*fakeHarvestingChain, *HarvestingManager) {
+	generationHash, err := StringToHash(testDiscoveryGenerationHash)
+	require.NoError(t, err)
+
+	account, err := NewAccount(MijinTest, generationHash)
+	require.NoError(t, err)
+
+	chain := newFakeHarvestingChain(t, account.PublicAccount, generationHash)
+	t.Cleanup(chain.Close)
+
+	client, err := chain.getClientByNetworkType(MijinTest)
+	require.NoError(t, err)
+	client.config.GenerationHash = generationHash
+	markSynced(client)
+
+	manager := NewHarvestingManager(client, account)
+	manager.PollInterval = time.Millisecond * 10
+	manager.ReportInterval = time.Millisecond * 10
+
+	return chain, manager
+}
+
+func TestHarvestingManager_EnableDisable(t *testing.T) {
+	chain, manager := newHarvestingFixture(t)
+
+	remote, harvester, err := manager.Enable(ctx, nil)
+	require.NoError(t, err)
+	require.NotNil(t, remote)
+	assert.True(t, harvester.CanHarvest)
+	assert.Equal(t, Height(42), harvester.LastSigningBlockHeight)
+	assert.Equal(t, []EntityType{LinkAccount, AddHarvesterEntityType}, chain.announcedTypes())
+
+	// already enabled
+	_, _, err = manager.Enable(ctx, remote)
+	require.NoError(t, err)
+	assert.Len(t, chain.announcedTypes(), 2)
+
+	monitorCtx, cancel := context.WithCancel(ctx)
+	report := <-manager.Monitor(monitorCtx, remote.PublicAccount)
+	cancel()
+	require.NoError(t, report.Err)
+	assert.Equal(t, Amount(1000), report.Harvester.EffectiveBalance)
+
+	require.NoError(t, manager.Disable(ctx, remote.PublicAccount))
+	assert.Equal(t, []EntityType{LinkAccount, AddHarvesterEntityType, RemoveHarvesterEntityType, LinkAccount}, chain.announcedTypes())
+
+	linked, err := manager.LinkedAccount(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, linked)
+	assert.ErrorIs(t, manager.Report(ctx, remote.PublicAccount).Err, ErrHarvesterNotRegistered)
+}
+
+func TestHarvestingManager_EnableKeepsRemoteOnError(t *testing.T) {
+	chain, manager := newHarvestingFixture(t)
+	chain.rejected = AddHarvesterEntityType
+
+	remote, harvester, err := manager.Enable(ctx, nil)
+	require.Error(t, err)
+	require.NotNil(t, remote)
+	assert.Nil(t, harvester)
+
+	linked, err := manager.LinkedAccount(ctx)
+	require.NoError(t, err)
+	assert.True(t, strings.EqualFold(remote.PublicAccount.PublicKey, linked.PublicKey))
+
+	chain.m.Lock()
+	chain.rejected = 0
+	chain.m.Unlock()
+
+	_, harvester, err = manager.Enable(ctx, remote)
+	require.NoError(t, err)
+	assert.True(t, harvester.CanHarvest)
+}
+
+func TestHarvestingManager_Rotate(t *testing.T) {
+	_, manager := newHarvestingFixture(t)
+
+	remote, _, err := manager.Enable(ctx, nil)
+	require.NoError(t, err)
+
+	other, err := manager.Client.NewAccount()
+	require.NoError(t, err)
+
+	_, _, err = manager.Enable(ctx, other)
+	assert.ErrorIs(t, err, ErrHarvesterLinkedToOther)
+
+	next, harvester, err := manager.Rotate(ctx, remote.PublicAccount, other)
+	require.NoError(t, err)
+	assert.Equal(t, other, next)
+	assert.True(t, strings.EqualFold(other.PublicAccount.PublicKey, harvester.Key))
+
+	old, err := manager.Harvester(ctx, remote.PublicAccount)
+	require.NoError(t, err)
+	assert.Nil(t, old)
+}
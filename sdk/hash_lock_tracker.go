@@ -0,0 +1,471 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultHashLockPollInterval = time.Second * 15
+	DefaultHashLockAlertMargin  = time.Minute * 30
+)
+
+type HashLockStage uint8
+
+// HashLockStage enums
+const (
+	// HashLockAnnounced means the lock is announced and not confirmed yet
+	HashLockAnnounced HashLockStage = iota
+	// HashLockActive means the lock is confirmed and the aggregate waits for cosignatures
+	HashLockActive
+	// HashLockCompleted means the aggregate is confirmed and the locked funds are returned
+	HashLockCompleted
+	// HashLockExpired means the lock is expired before the aggregate was confirmed and the funds are forfeited
+	HashLockExpired
+	// HashLockFailed means the lock is rejected by the network
+	HashLockFailed
+)
+
+func (s HashLockStage) String() string {
+	switch s {
+	case HashLockAnnounced:
+		return "announced"
+	case HashLockActive:
+		return "active"
+	case HashLockCompleted:
+		return "completed"
+	case HashLockExpired:
+		return "expired"
+	case HashLockFailed:
+		return "failed"
+	}
+
+	return fmt.Sprintf("%d", uint8(s))
+}
+
+// IsFinal returns true if the lock does not change anymore
+func (s HashLockStage) IsFinal() bool {
+	return s == HashLockCompleted || s == HashLockExpired || s == HashLockFailed
+}
+
+// TrackedHashLock is a hash lock of a bonded aggregate
+type TrackedHashLock struct {
+	LockHash      *Hash
+	AggregateHash *Hash
+	Mosaic        *Mosaic
+	Duration      Duration
+	// ExpiryHeight is known after the lock is confirmed
+	ExpiryHeight Height
+	Stage        HashLockStage
+	// MissingCosigners are signers of inner transactions who have not cosigned the aggregate yet
+	MissingCosigners []*PublicAccount
+	// Err is the status error of the rejected lock or aggregate
+	Err error
+}
+
+// HashLockEvent is sent when the stage of a lock changes or the lock is about to expire with missing cosignatures
+type HashLockEvent struct {
+	Lock *TrackedHashLock
+	// Expiring is true for alerts before expiry
+	Expiring bool
+	TimeLeft time.Duration
+	Err      error
+}
+
+// HashLockAggregate is a hash lock of an account with the aggregate it locks. Aggregate is nil when
+// the node does not know the aggregate
+type HashLockAggregate struct {
+	Lock      *HashLockInfo
+	Aggregate *AggregateTransaction
+	Group     TransactionGroup
+}
+
+// HashLockTracker follows hash locks of bonded aggregates until the aggregate is confirmed or the lock is expired.
+// Alerts are sent once per lock when less than AlertMargin is left before expiry and cosignatures are missing
+type HashLockTracker struct {
+	Client       *Client
+	PollInterval time.Duration
+	AlertMargin  time.Duration
+	// BlockTime is requested with Client.BlockGenerationTime when not set
+	BlockTime time.Duration
+
+	m       sync.Mutex
+	locks   []*TrackedHashLock
+	alerted map[Hash]bool
+}
+
+// returns HashLockTracker with default poll interval and alert margin
+func NewHashLockTracker(client *Client) *HashLockTracker {
+	return &HashLockTracker{
+		Client:       client,
+		PollInterval: DefaultHashLockPollInterval,
+		AlertMargin:  DefaultHashLockAlertMargin,
+	}
+}
+
+// Track starts following the lock announced with passed hash
+func (t *HashLockTracker) Track(lockHash *Hash, lock *LockFundsTransaction) (*TrackedHashLock, error) {
+	if lockHash == nil {
+		return nil, ErrNilHash
+	}
+
+	if lock == nil || lock.SignedTransaction == nil || lock.SignedTransaction.Hash == nil {
+		return nil, ErrInvalidHashLock
+	}
+
+	tracked := &TrackedHashLock{
+		LockHash:      lockHash,
+		AggregateHash: lock.SignedTransaction.Hash,
+		Mosaic:        lock.Mosaic,
+		Duration:      lock.Duration,
+		Stage:         HashLockAnnounced,
+	}
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.locks = append(t.locks, tracked)
+
+	return tracked.copy(), nil
+}
+
+// Announce signs and announces the lock with account and tracks it. The aggregate should be announced
+// with TransactionService.AnnounceAggregateBonded after the lock is confirmed
+func (t *HashLockTracker) Announce(ctx context.Context, account *Account, lock *LockFundsTransaction) (*TrackedHashLock, error) {
+	if account == nil {
+		return nil, ErrNilAccount
+	}
+
+	if lock == nil || lock.SignedTransaction == nil {
+		return nil, ErrInvalidHashLock
+	}
+
+	signed, err := account.Sign(lock)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := t.Client.Transaction.Announce(ctx, signed); err != nil {
+		return nil, err
+	}
+
+	return t.Track(signed.Hash, lock)
+}
+
+// Locks returns copies of tracked locks
+func (t *HashLockTracker) Locks() []*TrackedHashLock {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	locks := make([]*TrackedHashLock, 0, len(t.locks))
+	for _, l := range t.locks {
+		locks = append(locks, l.copy())
+	}
+
+	return locks
+}
+
+// Update checks every lock which is not final and returns events of changed and expiring locks.
+// Locks are checked on copies so the tracker is not locked during requests
+func (t *HashLockTracker) Update(ctx context.Context) ([]*HashLockEvent, error) {
+	height, err := t.Client.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tracked, pending := t.pending()
+
+	events := make([]*HashLockEvent, 0)
+	for i, l := range pending {
+		stage := l.Stage
+		if err := t.update(ctx, l, height); err != nil {
+			return events, err
+		}
+
+		t.store(tracked[i], l)
+
+		if l.Stage != stage {
+			events = append(events, &HashLockEvent{Lock: l.copy()})
+			continue
+		}
+
+		if l.Stage != HashLockActive || len(l.MissingCosigners) == 0 || t.isAlerted(l.LockHash) {
+			continue
+		}
+
+		left, err := t.timeLeft(ctx, l.ExpiryHeight, height)
+		if err != nil {
+			return events, err
+		}
+
+		if left < t.AlertMargin && t.markAlerted(l.LockHash) {
+			events = append(events, &HashLockEvent{Lock: l.copy(), Expiring: true, TimeLeft: left})
+		}
+	}
+
+	return events, nil
+}
+
+// Monitor calls Update every PollInterval and sends its events. Errors are sent as events with Err
+func (t *HashLockTracker) Monitor(ctx context.Context) <-chan *HashLockEvent {
+	events := make(chan *HashLockEvent)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(t.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			updated, err := t.Update(ctx)
+			if err != nil {
+				updated = append(updated, &HashLockEvent{Err: err})
+			}
+
+			for _, e := range updated {
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// AccountLocks returns hash locks of the account with aggregates they lock
+func (t *HashLockTracker) AccountLocks(ctx context.Context, account *PublicAccount) ([]*HashLockAggregate, error) {
+	infos, err := t.Client.Lock.GetHashLockInfosByAccount(ctx, account)
+	if IsNotFound(err) {
+		return []*HashLockAggregate{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	locks := make([]*HashLockAggregate, 0, len(infos))
+	for _, info := range infos {
+		aggregate, group, err := t.aggregate(ctx, info.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, &HashLockAggregate{Lock: info, Aggregate: aggregate, Group: group})
+	}
+
+	return locks, nil
+}
+
+// update moves the lock to the next stage. Locks which are used are completed, unused locks at the expiry height are expired
+func (t *HashLockTracker) update(ctx context.Context, l *TrackedHashLock, height Height) error {
+	if l.Stage == HashLockAnnounced {
+		status, err := t.Client.Transaction.GetTransactionStatus(ctx, l.LockHash.String())
+		switch {
+		case IsNotFound(err):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if err := status.Err(); err != nil {
+			l.Stage = HashLockFailed
+			l.Err = err
+			return nil
+		}
+
+		if status.Group != Confirmed {
+			return nil
+		}
+	}
+
+	info, err := t.Client.Lock.GetHashLockInfo(ctx, l.AggregateHash)
+	switch {
+	case IsNotFound(err):
+		info = nil
+	case err != nil:
+		return err
+	}
+
+	if info != nil {
+		l.ExpiryHeight = info.Height
+		l.Stage = HashLockActive
+
+		if info.Status == Used {
+			l.Stage = HashLockCompleted
+			l.MissingCosigners = nil
+			return nil
+		}
+	}
+
+	aggregate, group, err := t.aggregate(ctx, l.AggregateHash)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case group == Confirmed:
+		l.Stage = HashLockCompleted
+		l.MissingCosigners = nil
+		return nil
+	case aggregate != nil:
+		l.MissingCosigners = missingCosigners(aggregate)
+	}
+
+	if l.Stage == HashLockActive && height >= l.ExpiryHeight {
+		l.Stage = HashLockExpired
+	}
+
+	return nil
+}
+
+// aggregate returns the aggregate with the group it is in or nil when the node does not know it
+func (t *HashLockTracker) aggregate(ctx context.Context, hash *Hash) (*AggregateTransaction, TransactionGroup, error) {
+	status, err := t.Client.Transaction.GetTransactionStatus(ctx, hash.String())
+	if IsNotFound(err) {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	tx, err := t.Client.Transaction.GetTransaction(ctx, status.Group, hash.String())
+	if IsNotFound(err) {
+		return nil, status.Group, nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	aggregate, _ := tx.(*AggregateTransaction)
+
+	return aggregate, status.Group, nil
+}
+
+// pending returns locks which are not final with their copies
+func (t *HashLockTracker) pending() ([]*TrackedHashLock, []*TrackedHashLock) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	tracked := make([]*TrackedHashLock, 0, len(t.locks))
+	copies := make([]*TrackedHashLock, 0, len(t.locks))
+	for _, l := range t.locks {
+		if l.Stage.IsFinal() {
+			continue
+		}
+
+		tracked = append(tracked, l)
+		copies = append(copies, l.copy())
+	}
+
+	return tracked, copies
+}
+
+// store saves the checked copy of the tracked lock
+func (t *HashLockTracker) store(tracked, checked *TrackedHashLock) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	*tracked = *checked.copy()
+}
+
+func (t *HashLockTracker) isAlerted(lockHash *Hash) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return t.alerted[*lockHash]
+}
+
+// markAlerted returns false when the alert of the lock was already sent
+func (t *HashLockTracker) markAlerted(lockHash *Hash) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.alerted == nil {
+		t.alerted = make(map[Hash]bool)
+	}
+
+	if t.alerted[*lockHash] {
+		return false
+	}
+
+	t.alerted[*lockHash] = true
+
+	return true
+}
+
+func (t *HashLockTracker) timeLeft(ctx context.Context, expiry, height Height) (time.Duration, error) {
+	if height >= expiry {
+		return 0, nil
+	}
+
+	t.m.Lock()
+	blockTime := t.BlockTime
+	t.m.Unlock()
+
+	if blockTime == 0 {
+		var err error
+		blockTime, err = t.Client.BlockGenerationTime(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		t.m.Lock()
+		t.BlockTime = blockTime
+		t.m.Unlock()
+	}
+
+	return time.Duration(expiry-height) * blockTime, nil
+}
+
+func (l *TrackedHashLock) copy() *TrackedHashLock {
+	c := *l
+	c.MissingCosigners = append([]*PublicAccount(nil), l.MissingCosigners...)
+
+	return &c
+}
+
+// missingCosigners returns signers of inner transactions which neither signed nor cosigned the aggregate.
+// Cosignatories of multisig signers are not resolved
+func missingCosigners(aggregate *AggregateTransaction) []*PublicAccount {
+	signed := func(account *PublicAccount) bool {
+		if aggregate.Signer != nil && samePublicKey(aggregate.Signer.PublicKey, account.PublicKey) {
+			return true
+		}
+
+		for _, c := range aggregate.Cosignatures {
+			if c.Signer != nil && samePublicKey(c.Signer.PublicKey, account.PublicKey) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	missing := make([]*PublicAccount, 0)
+	seen := make(map[string]bool)
+	for _, tx := range aggregate.InnerTransactions {
+		signer := tx.GetAbstractTransaction().Signer
+		if signer == nil || seen[signer.PublicKey] || signed(signer) {
+			continue
+		}
+
+		seen[signer.PublicKey] = true
+		missing = append(missing, signer)
+	}
+
+	return missing
+}
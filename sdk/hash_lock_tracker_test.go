@@ -0,0 +1,268 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHashLocks struct {
+	LockService
+	locks map[Hash]*HashLockInfo
+}
+
+func (s *fakeHashLocks) GetHashLockInfo(_ context.Context, hash *Hash) (*HashLockInfo, error) {
+	info, ok := s.locks[*hash]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return info, nil
+}
+
+func (s *fakeHashLocks) GetHashLockInfosByAccount(context.Context, *PublicAccount) ([]*HashLockInfo, error) {
+	infos := make([]*HashLockInfo, 0, len(s.locks))
+	for _, info := range s.locks {
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+type fakeTransactionStatuses struct {
+	TransactionService
+	statuses  map[string]*TransactionStatus
+	txs       map[string]Transaction
+	announced int
+}
+
+func (s *fakeTransactionStatuses) Announce(context.Context, *SignedTransaction) (string, error) {
+	s.announced++
+	return "", nil
+}
+
+func (s *fakeTransactionStatuses) GetTransactionStatus(_ context.Context, id string) (*TransactionStatus, error) {
+	status, ok := s.statuses[id]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return status, nil
+}
+
+func (s *fakeTransactionStatuses) GetTransaction(_ context.Context, _ TransactionGroup, id string) (Transaction, error) {
+	tx, ok := s.txs[id]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return tx, nil
+}
+
+func TestHashLockTracker_Update(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+	config.GenerationHash = &Hash{}
+
+	owner, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	cosigner, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	locks := &fakeHashLocks{locks: make(map[Hash]*HashLockInfo)}
+	transactions := &fakeTransactionStatuses{statuses: make(map[string]*TransactionStatus), txs: make(map[string]Transaction)}
+	chain := &fakeChainHeight{height: 100}
+
+	client := NewClient(nil, config)
+	client.Lock = locks
+	client.Transaction = transactions
+	client.Blockchain = chain
+
+	lockFunds := func(message string) (*LockFundsTransaction, *AggregateTransaction) {
+		toCosigner, err := client.NewTransferTransaction(NewDeadline(time.Hour), cosigner.Address, []*Mosaic{}, NewPlainMessage(message))
+		require.NoError(t, err)
+		toCosigner.ToAggregate(owner.PublicAccount)
+		toOwner, err := client.NewTransferTransaction(NewDeadline(time.Hour), owner.Address, []*Mosaic{}, NewPlainMessage(message))
+		require.NoError(t, err)
+		toOwner.ToAggregate(cosigner.PublicAccount)
+
+		aggregate, err := client.NewBondedAggregateTransaction(NewDeadline(time.Hour), []Transaction{toCosigner, toOwner})
+		require.NoError(t, err)
+		signed, err := owner.Sign(aggregate)
+		require.NoError(t, err)
+		aggregate.Signer = owner.PublicAccount
+
+		lock, err := client.NewLockFundsTransaction(NewDeadline(time.Hour), newMosaicPanic(XpxNamespaceId, 10000000), 100, signed)
+		require.NoError(t, err)
+
+		return lock, aggregate
+	}
+
+	tracker := NewHashLockTracker(client)
+	tracker.BlockTime = time.Second * 15
+
+	lock, aggregate := lockFunds("expiring")
+	expiring, err := tracker.Announce(ctx, owner, lock)
+	require.NoError(t, err)
+	assert.Equal(t, 1, transactions.announced)
+	assert.Equal(t, HashLockAnnounced, expiring.Stage)
+	assert.Equal(t, lock.SignedTransaction.Hash, expiring.AggregateHash)
+
+	// nothing changes until the lock is confirmed
+	events, err := tracker.Update(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	transactions.statuses[expiring.LockHash.String()] = &TransactionStatus{Group: Confirmed, Status: "Success"}
+	transactions.statuses[expiring.AggregateHash.String()] = &TransactionStatus{Group: Partial, Status: "Success"}
+	transactions.txs[expiring.AggregateHash.String()] = aggregate
+	locks.locks[*expiring.AggregateHash] = &HashLockInfo{
+		CommonLockInfo: CommonLockInfo{Account: owner.PublicAccount, Amount: 10000000, Height: 200, Status: Unused},
+		Hash:           expiring.AggregateHash,
+	}
+
+	events, err = tracker.Update(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, HashLockActive, events[0].Lock.Stage)
+	assert.Equal(t, Height(200), events[0].Lock.ExpiryHeight)
+	assert.Equal(t, []*PublicAccount{cosigner.PublicAccount}, events[0].Lock.MissingCosigners)
+
+	// 100 blocks of 15 seconds are left, which is less than the alert margin
+	events, err = tracker.Update(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.True(t, events[0].Expiring)
+	assert.Equal(t, time.Minute*25, events[0].TimeLeft)
+
+	// alerts are sent once
+	events, err = tracker.Update(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	lock, aggregate = lockFunds("completed")
+	lockHash := &Hash{1}
+	completed, err := tracker.Track(lockHash, lock)
+	require.NoError(t, err)
+	transactions.statuses[lockHash.String()] = &TransactionStatus{Group: Confirmed, Status: "Success"}
+	transactions.statuses[completed.AggregateHash.String()] = &TransactionStatus{Group: Confirmed, Status: "Success"}
+	transactions.txs[completed.AggregateHash.String()] = aggregate
+	locks.locks[*completed.AggregateHash] = &HashLockInfo{
+		CommonLockInfo: CommonLockInfo{Account: owner.PublicAccount, Amount: 10000000, Height: 210, Status: Used},
+		Hash:           completed.AggregateHash,
+	}
+
+	chain.height = 200
+	events, err = tracker.Update(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, HashLockExpired, events[0].Lock.Stage)
+	assert.Equal(t, HashLockCompleted, events[1].Lock.Stage)
+
+	for _, l := range tracker.Locks() {
+		assert.True(t, l.Stage.IsFinal())
+	}
+
+	accountLocks, err := tracker.AccountLocks(ctx, owner.PublicAccount)
+	require.NoError(t, err)
+	require.Len(t, accountLocks, 2)
+	for _, l := range accountLocks {
+		assert.NotNil(t, l.Aggregate)
+		assert.NotEmpty(t, l.Group)
+	}
+}
+
+func TestHashLockTracker_Failed(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	transactions := &fakeTransactionStatuses{statuses: make(map[string]*TransactionStatus)}
+	client := NewClient(nil, config)
+	client.Transaction = transactions
+	client.Blockchain = &fakeChainHeight{height: 1}
+
+	tracker := NewHashLockTracker(client)
+
+	lockHash := &Hash{1}
+	_, err = tracker.Track(lockHash, &LockFundsTransaction{SignedTransaction: &SignedTransaction{Hash: &Hash{2}}})
+	require.NoError(t, err)
+
+	transactions.statuses[lockHash.String()] = &TransactionStatus{Status: "Failure_LockHash_Invalid_Mosaic_Amount"}
+
+	events, err := tracker.Update(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, HashLockFailed, events[0].Lock.Stage)
+	assert.Equal(t, ErrLockHashInvalidMosaicAmount, events[0].Lock.Err)
+
+	_, err = tracker.Track(lockHash, &LockFundsTransaction{})
+	assert.Equal(t, ErrInvalidHashLock, err)
+}
+
+type lockingTransactionStatuses struct {
+	*fakeTransactionStatuses
+	onStatus func()
+}
+
+func (s *lockingTransactionStatuses) GetTransactionStatus(ctx context.Context, id string) (*TransactionStatus, error) {
+	s.onStatus()
+	return s.fakeTransactionStatuses.GetTransactionStatus(ctx, id)
+}
+
+func TestHashLockTracker_ZeroValue(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	owner, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	cosigner, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	client := NewClient(nil, config)
+	tracker := &HashLockTracker{Client: client, AlertMargin: time.Hour, BlockTime: time.Second * 15}
+
+	// the tracker is not locked during requests
+	transactions := &lockingTransactionStatuses{
+		fakeTransactionStatuses: &fakeTransactionStatuses{statuses: make(map[string]*TransactionStatus), txs: make(map[string]Transaction)},
+		onStatus:                func() { tracker.Locks() },
+	}
+	client.Transaction = transactions
+	client.Lock = &fakeHashLocks{locks: map[Hash]*HashLockInfo{
+		{2}: {CommonLockInfo: CommonLockInfo{Height: 200, Status: Unused}, Hash: &Hash{2}},
+	}}
+	client.Blockchain = &fakeChainHeight{height: 100}
+
+	lockHash, aggregateHash := &Hash{1}, &Hash{2}
+	_, err = tracker.Track(lockHash, &LockFundsTransaction{SignedTransaction: &SignedTransaction{Hash: aggregateHash}})
+	require.NoError(t, err)
+
+	transactions.statuses[lockHash.String()] = &TransactionStatus{Group: Confirmed, Status: "Success"}
+	transactions.statuses[aggregateHash.String()] = &TransactionStatus{Group: Partial, Status: "Success"}
+	transactions.txs[aggregateHash.String()] = &AggregateTransaction{
+		AbstractTransaction: AbstractTransaction{Signer: owner.PublicAccount},
+		InnerTransactions: []Transaction{
+			&TransferTransaction{AbstractTransaction: AbstractTransaction{Signer: cosigner.PublicAccount}},
+		},
+	}
+
+	events, err := tracker.Update(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, HashLockActive, events[0].Lock.Stage)
+
+	events, err = tracker.Update(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.True(t, events[0].Expiring)
+
+	events, err = tracker.Update(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
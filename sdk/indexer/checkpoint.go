@@ -0,0 +1,82 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+// Checkpoint is the last block written to the sink
+type Checkpoint struct {
+	Height sdk.Height `json:"height"`
+	Hash   string     `json:"hash"`
+}
+
+// CheckpointStore persists the checkpoint between restarts.
+// Load returns nil checkpoint when nothing was indexed yet.
+type CheckpointStore interface {
+	Load() (*Checkpoint, error)
+	Save(checkpoint *Checkpoint) error
+}
+
+type fileCheckpointStore struct {
+	path string
+}
+
+// NewFileCheckpointStore returns CheckpointStore which keeps the checkpoint in a json file
+func NewFileCheckpointStore(path string) CheckpointStore {
+	return &fileCheckpointStore{path: path}
+}
+
+func (s *fileCheckpointStore) Load() (*Checkpoint, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+// Save writes to a temporary file and renames it, so a crash never leaves a broken checkpoint
+func (s *fileCheckpointStore) Save(checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// Package indexer follows the chain and writes confirmed blocks with their transactions to a pluggable Sink.
+package indexer
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket"
+)
+
+const (
+	DefaultBatchSize         sdk.Amount = 25
+	DefaultPollInterval                 = time.Second * 15
+	DefaultMaxRollbackBlocks            = 360
+	transactionsPageSize                = 100
+)
+
+var (
+	ErrRollbackTooDeep    = errors.New("rollback is deeper than kept block hashes")
+	ErrInvalidStartHeight = errors.New("start height should be positive")
+)
+
+// Indexer writes blocks to Sink in height order starting after the last checkpoint.
+// When a block does not continue the last written one, written blocks are rolled back
+// down to the common block and indexing continues from it.
+type Indexer struct {
+	Client      *sdk.Client
+	Sink        Sink
+	Checkpoints CheckpointStore
+	// Websocket is optional. Block notifications trigger catch-up at once instead of waiting for PollInterval.
+	// The caller is responsible for listening the client.
+	Websocket websocket.CatapultClient
+	// StartHeight is the first indexed height when there is no checkpoint yet
+	StartHeight  sdk.Height
+	BatchSize    sdk.Amount
+	PollInterval time.Duration
+	// MaxRollbackBlocks is the number of recent block hashes kept to find the common block after a rollback
+	MaxRollbackBlocks int
+
+	recent []Checkpoint
+}
+
+// returns Indexer with default batch size, poll interval and rollback depth starting from the first block
+func NewIndexer(client *sdk.Client, sink Sink, checkpoints CheckpointStore) *Indexer {
+	return &Indexer{
+		Client:            client,
+		Sink:              sink,
+		Checkpoints:       checkpoints,
+		StartHeight:       1,
+		BatchSize:         DefaultBatchSize,
+		PollInterval:      DefaultPollInterval,
+		MaxRollbackBlocks: DefaultMaxRollbackBlocks,
+	}
+}
+
+// Checkpoint returns the last written block
+func (i *Indexer) Checkpoint() Checkpoint {
+	if len(i.recent) == 0 {
+		return Checkpoint{}
+	}
+
+	return i.recent[len(i.recent)-1]
+}
+
+// Run indexes blocks until ctx is done or an error occurs. It is safe to call Run again after an error,
+// indexing resumes from the durable checkpoint.
+func (i *Indexer) Run(ctx context.Context) error {
+	if err := i.restore(ctx); err != nil {
+		return err
+	}
+
+	var blocks <-chan *sdk.BlockInfo
+	if i.Websocket != nil {
+		sub, id, err := i.Websocket.NewBlockSubscription()
+		if err != nil {
+			return err
+		}
+		defer i.Websocket.BlockUnsubscribe(id)
+
+		blocks = sub
+	}
+
+	ticker := time.NewTicker(i.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := i.catchUp(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case _, ok := <-blocks:
+			if !ok {
+				blocks = nil
+			}
+		}
+	}
+}
+
+// restore loads the checkpoint, drops everything the sink received after it and reloads recent block hashes
+func (i *Indexer) restore(ctx context.Context) error {
+	checkpoint, err := i.Checkpoints.Load()
+	if err != nil {
+		return err
+	}
+
+	if checkpoint == nil {
+		if i.StartHeight < 1 {
+			return ErrInvalidStartHeight
+		}
+
+		checkpoint = &Checkpoint{Height: i.StartHeight - 1}
+	}
+
+	if err := i.Sink.Rollback(ctx, checkpoint.Height); err != nil {
+		return err
+	}
+
+	i.recent = []Checkpoint{*checkpoint}
+
+	hashes, ok := i.Sink.(BlockHashes)
+	if !ok {
+		return nil
+	}
+
+	recent, err := hashes.RecentBlocks(ctx, checkpoint.Height, i.MaxRollbackBlocks+1)
+	if err != nil {
+		return err
+	}
+
+	// the sink may lack hashes when the checkpoint store is kept apart from it
+	if len(recent) > 0 && recent[len(recent)-1] == *checkpoint {
+		i.recent = recent
+	}
+
+	return nil
+}
+
+func (i *Indexer) catchUp(ctx context.Context) error {
+	chainHeight, err := i.Client.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i.Checkpoint().Height < chainHeight {
+		next := i.Checkpoint().Height + 1
+
+		infos, err := i.Client.Blockchain.GetBlocksByHeightWithLimit(ctx, next, i.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		sort.Slice(infos, func(a, b int) bool {
+			return infos[a].Height < infos[b].Height
+		})
+
+		written := 0
+		for _, info := range infos {
+			if info.Height != i.Checkpoint().Height+1 {
+				continue
+			}
+
+			if !i.continues(info) {
+				last := i.Checkpoint()
+				if err := i.rollback(ctx); err != nil {
+					return err
+				}
+
+				// the kept block is still in the chain, but the next one doesn't continue it.
+				// The node is in the middle of a reorganization, so retry on the next poll
+				if i.Checkpoint() == last {
+					return nil
+				}
+
+				written++
+				break
+			}
+
+			if err := i.write(ctx, info); err != nil {
+				return err
+			}
+
+			written++
+		}
+
+		if written == 0 {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (i *Indexer) continues(info *sdk.BlockInfo) bool {
+	last := i.Checkpoint()
+	if last.Hash == "" || info.PreviousBlockHash == nil {
+		return true
+	}
+
+	return strings.EqualFold(info.PreviousBlockHash.String(), last.Hash)
+}
+
+func (i *Indexer) write(ctx context.Context, info *sdk.BlockInfo) error {
+	txs, err := i.transactions(ctx, info)
+	if err != nil {
+		return err
+	}
+
+	if err := i.Sink.WriteBlock(ctx, &Block{Info: info, Transactions: txs}); err != nil {
+		return err
+	}
+
+	checkpoint := Checkpoint{Height: info.Height, Hash: hashString(info.BlockHash)}
+	if err := i.Checkpoints.Save(&checkpoint); err != nil {
+		return err
+	}
+
+	i.recent = append(i.recent, checkpoint)
+	if len(i.recent) > i.MaxRollbackBlocks+1 {
+		i.recent = i.recent[len(i.recent)-i.MaxRollbackBlocks-1:]
+	}
+
+	return nil
+}
+
+func (i *Indexer) transactions(ctx context.Context, info *sdk.BlockInfo) ([]sdk.Transaction, error) {
+	txs := make([]sdk.Transaction, 0, info.NumTransactions)
+	if info.NumTransactions == 0 {
+		return txs, nil
+	}
+
+	for page := uint64(1); ; page++ {
+		txPage, err := i.Client.Transaction.GetTransactionsByGroup(ctx, sdk.Confirmed, &sdk.TransactionsPageOptions{
+			Height:     uint(info.Height),
+			FirstLevel: true,
+			PaginationOrderingOptions: sdk.PaginationOrderingOptions{
+				PageSize:   transactionsPageSize,
+				PageNumber: page,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, txPage.Transactions...)
+
+		if page >= txPage.Pagination.TotalPages {
+			return txs, nil
+		}
+	}
+}
+
+// rollback finds the last kept block which is still in the chain and removes everything above it
+func (i *Indexer) rollback(ctx context.Context) error {
+	for j := len(i.recent) - 1; j >= 0; j-- {
+		checkpoint := i.recent[j]
+
+		if checkpoint.Hash != "" {
+			info, err := i.Client.Blockchain.GetBlockByHeight(ctx, checkpoint.Height)
+			if err != nil {
+				return err
+			}
+
+			if !strings.EqualFold(hashString(info.BlockHash), checkpoint.Hash) {
+				continue
+			}
+		}
+
+		if err := i.Sink.Rollback(ctx, checkpoint.Height); err != nil {
+			return err
+		}
+
+		if err := i.Checkpoints.Save(&checkpoint); err != nil {
+			return err
+		}
+
+		i.recent = i.recent[:j+1]
+		return nil
+	}
+
+	return ErrRollbackTooDeep
+}
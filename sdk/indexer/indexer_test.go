@@ -0,0 +1,304 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket"
+	"github.com/proximax-storage/go-xpx-chain-sdk/test/fakenode"
+)
+
+var ctx = context.Background()
+
+type fixture struct {
+	node        *fakenode.Node
+	config      *sdk.Config
+	client      *sdk.Client
+	owner       *sdk.Account
+	mosaicId    *sdk.MosaicId
+	dir         string
+	sink        Sink
+	checkpoints CheckpointStore
+}
+
+func newFixture(t *testing.T) *fixture {
+	f := &fixture{node: fakenode.New(fakenode.Config{}), dir: t.TempDir()}
+	t.Cleanup(f.node.Close)
+
+	var err error
+	f.config, err = sdk.NewConfig(ctx, []string{f.node.URL()})
+	require.NoError(t, err)
+	f.client = sdk.NewClient(nil, f.config)
+
+	f.owner, err = f.client.NewAccount()
+	require.NoError(t, err)
+
+	f.mosaicId, err = sdk.NewMosaicId(0x0DC67FBE1CAD29E3)
+	require.NoError(t, err)
+	f.node.AddMosaic(f.owner.PublicAccount, f.mosaicId, 1000, sdk.NewMosaicProperties(true, true, 0, 0))
+
+	f.sink, err = NewJSONLSink(filepath.Join(f.dir, "blocks.jsonl"))
+	require.NoError(t, err)
+	t.Cleanup(func() { f.sink.Close() })
+
+	f.checkpoints = NewFileCheckpointStore(filepath.Join(f.dir, "checkpoint.json"))
+
+	return f
+}
+
+func (f *fixture) indexer() *Indexer {
+	return NewIndexer(f.client, f.sink, f.checkpoints)
+}
+
+func (f *fixture) block(t *testing.T, transfers int) {
+	for i := 0; i < transfers; i++ {
+		recipient, err := f.client.NewAccount()
+		require.NoError(t, err)
+
+		mosaic, err := sdk.NewMosaic(f.mosaicId, 1)
+		require.NoError(t, err)
+
+		tx, err := f.client.NewTransferTransaction(sdk.NewDeadline(time.Hour), recipient.Address, []*sdk.Mosaic{mosaic}, sdk.NewPlainMessage(""))
+		require.NoError(t, err)
+
+		signed, err := f.owner.Sign(tx)
+		require.NoError(t, err)
+
+		_, err = f.client.Transaction.Announce(ctx, signed)
+		require.NoError(t, err)
+	}
+
+	f.node.GenerateBlock()
+}
+
+// records returns written blocks and checks that they follow the chain
+func (f *fixture) records(t *testing.T) []*BlockRecord {
+	file, err := os.Open(filepath.Join(f.dir, "blocks.jsonl"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	records := make([]*BlockRecord, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		r := &BlockRecord{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), r))
+		records = append(records, r)
+	}
+	require.NoError(t, scanner.Err())
+
+	for i, r := range records {
+		assert.Equal(t, uint64(i+1), r.Height)
+		assert.Len(t, r.Transactions, int(r.NumTransactions))
+		if i > 0 {
+			assert.Equal(t, records[i-1].Hash, r.PreviousBlockHash)
+		}
+
+		info, err := f.client.Blockchain.GetBlockByHeight(ctx, sdk.Height(r.Height))
+		require.NoError(t, err)
+		assert.Equal(t, info.BlockHash.String(), r.Hash)
+	}
+
+	return records
+}
+
+func TestIndexer_CatchUp(t *testing.T) {
+	f := newFixture(t)
+	f.block(t, 2)
+	f.block(t, 0)
+	f.block(t, 1)
+
+	i := f.indexer()
+	i.BatchSize = 2
+	require.NoError(t, i.restore(ctx))
+	require.NoError(t, i.catchUp(ctx))
+
+	records := f.records(t)
+	require.Len(t, records, 4)
+	assert.Len(t, records[1].Transactions, 2)
+	assert.Equal(t, uint16(sdk.Transfer), records[1].Transactions[0].Type)
+	assert.Equal(t, f.owner.PublicAccount.PublicKey, records[1].Transactions[0].Signer)
+
+	checkpoint, err := f.checkpoints.Load()
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Height(4), checkpoint.Height)
+	assert.Equal(t, records[3].Hash, checkpoint.Hash)
+}
+
+func TestIndexer_Rollback(t *testing.T) {
+	f := newFixture(t)
+	f.block(t, 1)
+	f.block(t, 1)
+	f.block(t, 1)
+
+	i := f.indexer()
+	require.NoError(t, i.restore(ctx))
+	require.NoError(t, i.catchUp(ctx))
+	require.Len(t, f.records(t), 4)
+
+	f.node.Rollback(2)
+	f.block(t, 0)
+	f.block(t, 1)
+	f.block(t, 0)
+
+	require.NoError(t, i.catchUp(ctx))
+
+	records := f.records(t)
+	require.Len(t, records, 5)
+	assert.Equal(t, sdk.Height(5), i.Checkpoint().Height)
+}
+
+func TestIndexer_Restart(t *testing.T) {
+	f := newFixture(t)
+	f.block(t, 1)
+
+	i := f.indexer()
+	require.NoError(t, i.restore(ctx))
+	require.NoError(t, i.catchUp(ctx))
+
+	// a block written without a checkpoint and a broken line left by a crash
+	f.block(t, 1)
+	info, err := f.client.Blockchain.GetBlockByHeight(ctx, 3)
+	require.NoError(t, err)
+	require.NoError(t, f.sink.WriteBlock(ctx, &Block{Info: info}))
+	file, err := os.OpenFile(filepath.Join(f.dir, "blocks.jsonl"), os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"height":4,"ha`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	i = f.indexer()
+	require.NoError(t, i.restore(ctx))
+	assert.Equal(t, sdk.Height(2), i.Checkpoint().Height)
+	require.NoError(t, i.catchUp(ctx))
+
+	records := f.records(t)
+	require.Len(t, records, 3)
+	assert.Len(t, records[2].Transactions, 1)
+}
+
+// restartRollback restarts the indexer after the chain dropped the checkpoint block
+func restartRollback(t *testing.T, f *fixture) {
+	f.block(t, 1)
+	f.block(t, 1)
+	f.block(t, 1)
+
+	i := f.indexer()
+	require.NoError(t, i.restore(ctx))
+	require.NoError(t, i.catchUp(ctx))
+	require.Equal(t, sdk.Height(4), i.Checkpoint().Height)
+
+	f.node.Rollback(2)
+	f.block(t, 0)
+	f.block(t, 1)
+	f.block(t, 0)
+
+	i = f.indexer()
+	require.NoError(t, i.restore(ctx))
+	require.NoError(t, i.catchUp(ctx))
+
+	info, err := f.client.Blockchain.GetBlockByHeight(ctx, 5)
+	require.NoError(t, err)
+	checkpoint, err := f.checkpoints.Load()
+	require.NoError(t, err)
+	assert.Equal(t, &Checkpoint{Height: 5, Hash: info.BlockHash.String()}, checkpoint)
+}
+
+func TestIndexer_RestartRollback(t *testing.T) {
+	f := newFixture(t)
+	restartRollback(t, f)
+	assert.Len(t, f.records(t), 5)
+}
+
+func TestIndexer_Run(t *testing.T) {
+	f := newFixture(t)
+
+	ws, err := websocket.NewClient(f.config)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go ws.Listen(runCtx)
+
+	i := f.indexer()
+	i.Websocket = ws
+	i.PollInterval = time.Hour
+
+	done := make(chan error)
+	go func() {
+		done <- i.Run(runCtx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return f.node.HasSubscribers("block")
+	}, time.Second*5, time.Millisecond*10)
+
+	f.block(t, 1)
+
+	require.Eventually(t, func() bool {
+		checkpoint, err := f.checkpoints.Load()
+		return err == nil && checkpoint != nil && checkpoint.Height == 2
+	}, time.Second*5, time.Millisecond*10)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Len(t, f.records(t), 2)
+}
+
+// forkedChain serves a block which doesn't continue the kept one, while the kept one is still in the chain
+type forkedChain struct {
+	sdk.BlockchainService
+	kept, next *sdk.BlockInfo
+	requests   int
+}
+
+func (c *forkedChain) GetBlockchainHeight(context.Context) (sdk.Height, error) {
+	return c.next.Height, nil
+}
+
+func (c *forkedChain) GetBlocksByHeightWithLimit(context.Context, sdk.Height, sdk.Amount) ([]*sdk.BlockInfo, error) {
+	c.requests++
+	return []*sdk.BlockInfo{c.next}, nil
+}
+
+func (c *forkedChain) GetBlockByHeight(context.Context, sdk.Height) (*sdk.BlockInfo, error) {
+	return c.kept, nil
+}
+
+func TestIndexer_RollbackWithoutProgress(t *testing.T) {
+	f := newFixture(t)
+
+	chain := &forkedChain{
+		kept: &sdk.BlockInfo{Height: 1, BlockHash: &sdk.Hash{1}},
+		next: &sdk.BlockInfo{Height: 2, BlockHash: &sdk.Hash{3}, PreviousBlockHash: &sdk.Hash{2}},
+	}
+	f.client.Blockchain = chain
+
+	i := f.indexer()
+	i.recent = []Checkpoint{{Height: 1, Hash: hashString(chain.kept.BlockHash)}}
+
+	require.NoError(t, i.catchUp(ctx))
+	assert.Equal(t, 1, chain.requests)
+	assert.Equal(t, sdk.Height(1), i.Checkpoint().Height)
+}
+
+func TestIndexer_StartHeight(t *testing.T) {
+	f := newFixture(t)
+
+	i := f.indexer()
+	i.StartHeight = 0
+	assert.Equal(t, ErrInvalidStartHeight, i.restore(ctx))
+}
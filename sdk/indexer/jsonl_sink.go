@@ -0,0 +1,176 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+type jsonlSink struct {
+	m    sync.Mutex
+	path string
+	file *os.File
+}
+
+// NewJSONLSink returns Sink which appends one BlockRecord per line to the file
+func NewJSONLSink(path string) (Sink, error) {
+	file, err := openJSONL(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonlSink{path: path, file: file}, nil
+}
+
+func openJSONL(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+}
+
+func (s *jsonlSink) WriteBlock(ctx context.Context, block *Block) error {
+	data, err := json.Marshal(NewBlockRecord(block))
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+// Rollback rewrites the file without blocks above height. A broken last line left by a crash is dropped too.
+func (s *jsonlSink) Rollback(ctx context.Context, height sdk.Height) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	src, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := copyBlocks(tmp, src, height); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	s.file, err = openJSONL(s.path)
+	return err
+}
+
+func copyBlocks(dst io.Writer, src io.Reader, height sdk.Height) error {
+	return readBlocks(src, height, func(line []byte, _ *Checkpoint) error {
+		_, err := dst.Write(line)
+		return err
+	})
+}
+
+// readBlocks calls fn for every complete line of blocks at or below height
+func readBlocks(src io.Reader, height sdk.Height, fn func(line []byte, block *Checkpoint) error) error {
+	reader := bufio.NewReader(src)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// the last line without a new line symbol is an unfinished write
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		record := &struct {
+			Height uint64 `json:"height"`
+			Hash   string `json:"hash"`
+		}{}
+		if err := json.Unmarshal(bytes.TrimSpace(line), record); err != nil {
+			return err
+		}
+
+		if sdk.Height(record.Height) > height {
+			return nil
+		}
+
+		if err := fn(line, &Checkpoint{Height: sdk.Height(record.Height), Hash: record.Hash}); err != nil {
+			return err
+		}
+	}
+}
+
+// RecentBlocks reads the whole file, blocks are not indexed by height
+func (s *jsonlSink) RecentBlocks(ctx context.Context, height sdk.Height, limit int) ([]Checkpoint, error) {
+	if limit < 1 {
+		return nil, nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	src, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	recent := make([]Checkpoint, 0, 2*limit)
+	err = readBlocks(src, height, func(_ []byte, block *Checkpoint) error {
+		if len(recent) == cap(recent) {
+			recent = append(recent[:0], recent[len(recent)-limit:]...)
+		}
+		recent = append(recent, *block)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recent) > limit {
+		recent = recent[len(recent)-limit:]
+	}
+
+	return recent, nil
+}
+
+func (s *jsonlSink) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.file.Close()
+}
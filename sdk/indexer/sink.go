@@ -0,0 +1,120 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+// Block is a confirmed block with its top level transactions
+type Block struct {
+	Info         *sdk.BlockInfo
+	Transactions []sdk.Transaction
+}
+
+// Sink receives indexed blocks in height order
+type Sink interface {
+	io.Closer
+
+	// WriteBlock stores the block. Blocks are written in height order without gaps
+	WriteBlock(ctx context.Context, block *Block) error
+	// Rollback removes every block above height with its transactions
+	Rollback(ctx context.Context, height sdk.Height) error
+}
+
+// BlockHashes is implemented by sinks which can list written blocks. Indexer reloads recent block hashes
+// from it on start, so a reorganization which drops the checkpoint block is rolled back after a restart too
+type BlockHashes interface {
+	// RecentBlocks returns up to limit last written blocks at or below height in height order
+	RecentBlocks(ctx context.Context, height sdk.Height, limit int) ([]Checkpoint, error)
+}
+
+// BlockRecord is a flat representation of a block used by the built-in sinks
+type BlockRecord struct {
+	Height            uint64              `json:"height"`
+	Hash              string              `json:"hash"`
+	PreviousBlockHash string              `json:"previousBlockHash"`
+	Timestamp         time.Time           `json:"timestamp"`
+	Signer            string              `json:"signer"`
+	TotalFee          uint64              `json:"totalFee"`
+	NumTransactions   uint64              `json:"numTransactions"`
+	Transactions      []TransactionRecord `json:"transactions"`
+}
+
+// TransactionRecord is a flat representation of a transaction used by the built-in sinks
+type TransactionRecord struct {
+	Hash     string    `json:"hash"`
+	Height   uint64    `json:"height"`
+	Index    uint32    `json:"index"`
+	Type     uint16    `json:"type"`
+	Version  uint32    `json:"version"`
+	Signer   string    `json:"signer"`
+	MaxFee   uint64    `json:"maxFee"`
+	Deadline time.Time `json:"deadline"`
+}
+
+// NewBlockRecord converts the block to a BlockRecord
+func NewBlockRecord(block *Block) *BlockRecord {
+	info := block.Info
+	r := &BlockRecord{
+		Height:          uint64(info.Height),
+		Hash:            hashString(info.BlockHash),
+		TotalFee:        uint64(info.TotalFee),
+		NumTransactions: info.NumTransactions,
+		Transactions:    make([]TransactionRecord, 0, len(block.Transactions)),
+	}
+
+	if info.PreviousBlockHash != nil {
+		r.PreviousBlockHash = info.PreviousBlockHash.String()
+	}
+
+	if info.Timestamp != nil {
+		r.Timestamp = info.Timestamp.Time.UTC()
+	}
+
+	if info.Signer != nil {
+		r.Signer = info.Signer.PublicKey
+	}
+
+	for _, tx := range block.Transactions {
+		r.Transactions = append(r.Transactions, newTransactionRecord(tx))
+	}
+
+	return r
+}
+
+func newTransactionRecord(tx sdk.Transaction) TransactionRecord {
+	atx := tx.GetAbstractTransaction()
+	r := TransactionRecord{
+		Hash:    hashString(atx.TransactionHash),
+		Height:  uint64(atx.Height),
+		Index:   atx.Index,
+		Type:    uint16(atx.Type),
+		Version: uint32(atx.Version),
+		MaxFee:  uint64(atx.MaxFee),
+	}
+
+	if atx.Signer != nil {
+		r.Signer = atx.Signer.PublicKey
+	}
+
+	if atx.Deadline != nil {
+		r.Deadline = atx.Deadline.Time.UTC()
+	}
+
+	return r
+}
+
+func hashString(hash *sdk.Hash) string {
+	if hash == nil {
+		return ""
+	}
+
+	return hash.String()
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+//go:build sqlite
+
+package indexer
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+// Tests against a real SQLite database. Run them with go test -tags sqlite ./sdk/indexer/...
+// after go get modernc.org/sqlite, the driver is not a dependency of the sdk
+
+func newRealSQLiteFixture(t *testing.T) (*fixture, *sql.DB) {
+	return openSQLiteFixture(t, "sqlite", filepath.Join(t.TempDir(), "index.db"))
+}
+
+func TestSQLiteDriver_CatchUp(t *testing.T) {
+	f, _ := newRealSQLiteFixture(t)
+	f.block(t, 2)
+	f.block(t, 1)
+
+	i := f.indexer()
+	require.NoError(t, i.restore(ctx))
+	require.NoError(t, i.catchUp(ctx))
+
+	recent, err := f.sink.(BlockHashes).RecentBlocks(ctx, 3, 2)
+	require.NoError(t, err)
+	require.Len(t, recent, 2)
+	assert.Equal(t, i.Checkpoint(), recent[1])
+
+	info, err := f.client.Blockchain.GetBlockByHeight(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{Height: 2, Hash: info.BlockHash.String()}, recent[0])
+}
+
+func TestSQLiteDriver_RestartRollback(t *testing.T) {
+	f, db := newRealSQLiteFixture(t)
+	restartRollback(t, f)
+	assert.Equal(t, 5, count(t, db, `SELECT COUNT(*) FROM blocks`))
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+var sqliteSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		height INTEGER PRIMARY KEY,
+		hash TEXT NOT NULL,
+		previous_hash TEXT NOT NULL,
+		timestamp INTEGER NOT NULL,
+		signer TEXT NOT NULL,
+		total_fee INTEGER NOT NULL,
+		num_transactions INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		hash TEXT PRIMARY KEY,
+		height INTEGER NOT NULL,
+		idx INTEGER NOT NULL,
+		type INTEGER NOT NULL,
+		version INTEGER NOT NULL,
+		signer TEXT NOT NULL,
+		max_fee INTEGER NOT NULL,
+		deadline INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS transactions_height ON transactions (height)`,
+	`CREATE TABLE IF NOT EXISTS checkpoint (
+		id INTEGER PRIMARY KEY CHECK (id = 0),
+		height INTEGER NOT NULL,
+		hash TEXT NOT NULL
+	)`,
+}
+
+// SQLiteSink stores blocks and transactions in SQLite tables. It is a CheckpointStore too,
+// the checkpoint is updated in the same database transaction as the block, so they never diverge.
+type SQLiteSink struct {
+	db *sql.DB
+}
+
+// NewSQLiteSink creates tables in db if they do not exist. db should be opened with any SQLite driver,
+// e.g. sql.Open("sqlite3", "index.db"), the sink does not depend on a particular one
+func NewSQLiteSink(ctx context.Context, db *sql.DB) (*SQLiteSink, error) {
+	for _, stmt := range sqliteSchema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SQLiteSink{db: db}, nil
+}
+
+func (s *SQLiteSink) WriteBlock(ctx context.Context, block *Block) error {
+	record := NewBlockRecord(block)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO blocks (height, hash, previous_hash, timestamp, signer, total_fee, num_transactions) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		int64(record.Height),
+		record.Hash,
+		record.PreviousBlockHash,
+		record.Timestamp.UnixMilli(),
+		record.Signer,
+		int64(record.TotalFee),
+		int64(record.NumTransactions),
+	); err != nil {
+		return err
+	}
+
+	for _, t := range record.Transactions {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO transactions (hash, height, idx, type, version, signer, max_fee, deadline) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			t.Hash,
+			int64(t.Height),
+			int64(t.Index),
+			int64(t.Type),
+			int64(t.Version),
+			t.Signer,
+			int64(t.MaxFee),
+			t.Deadline.UnixMilli(),
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := saveCheckpoint(ctx, tx, &Checkpoint{Height: sdk.Height(record.Height), Hash: record.Hash}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLiteSink) Rollback(ctx context.Context, height sdk.Height) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM transactions WHERE height > ?`, int64(height)); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM blocks WHERE height > ?`, int64(height)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLiteSink) RecentBlocks(ctx context.Context, height sdk.Height, limit int) ([]Checkpoint, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT height, hash FROM blocks WHERE height <= ? ORDER BY height DESC LIMIT ?`,
+		int64(height),
+		int64(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recent := make([]Checkpoint, 0, limit)
+	for rows.Next() {
+		var (
+			height int64
+			hash   string
+		)
+
+		if err := rows.Scan(&height, &hash); err != nil {
+			return nil, err
+		}
+
+		recent = append(recent, Checkpoint{Height: sdk.Height(height), Hash: hash})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for l, r := 0, len(recent)-1; l < r; l, r = l+1, r-1 {
+		recent[l], recent[r] = recent[r], recent[l]
+	}
+
+	return recent, nil
+}
+
+func (s *SQLiteSink) Load() (*Checkpoint, error) {
+	var (
+		height int64
+		hash   string
+	)
+
+	err := s.db.QueryRow(`SELECT height, hash FROM checkpoint WHERE id = 0`).Scan(&height, &hash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Checkpoint{Height: sdk.Height(height), Hash: hash}, nil
+}
+
+func (s *SQLiteSink) Save(checkpoint *Checkpoint) error {
+	return saveCheckpoint(context.Background(), s.db, checkpoint)
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func saveCheckpoint(ctx context.Context, db execer, checkpoint *Checkpoint) error {
+	_, err := db.ExecContext(
+		ctx,
+		`INSERT OR REPLACE INTO checkpoint (id, height, hash) VALUES (0, ?, ?)`,
+		int64(checkpoint.Height),
+		checkpoint.Hash,
+	)
+
+	return err
+}
+
+// Close does not close the db, it is owned by the caller
+func (s *SQLiteSink) Close() error {
+	return nil
+}
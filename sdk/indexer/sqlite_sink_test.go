@@ -0,0 +1,416 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+const memoryDriverName = "indexer-memory"
+
+var (
+	insertStatement = regexp.MustCompile(`^INSERT (OR REPLACE )?INTO (\w+) \(([^)]*)\) VALUES \(([^)]*)\)$`)
+	deleteStatement = regexp.MustCompile(`^DELETE FROM (\w+) WHERE (\w+) > \?$`)
+	selectStatement = regexp.MustCompile(`^SELECT (.+) FROM (\w+)(?: WHERE (\w+) (=|<=) (\?|\d+))?(?: ORDER BY (\w+) DESC LIMIT \?)?$`)
+
+	errUniqueConstraint = errors.New("UNIQUE constraint failed")
+)
+
+func init() {
+	sql.Register(memoryDriverName, &memoryDriver{dbs: make(map[string]*memoryDB)})
+}
+
+// memoryDriver keeps tables in memory. It understands only statements of SQLiteSink: inserts with
+// the primary key in the first column, deletes by a lower bound and selects by a column value or an upper bound
+type memoryDriver struct {
+	m   sync.Mutex
+	dbs map[string]*memoryDB
+}
+
+func (d *memoryDriver) Open(name string) (driver.Conn, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	db, ok := d.dbs[name]
+	if !ok {
+		db = &memoryDB{tables: make(map[string]*memoryTable)}
+		d.dbs[name] = db
+	}
+
+	return &memoryConn{db: db}, nil
+}
+
+type memoryDB struct {
+	m      sync.Mutex
+	tables map[string]*memoryTable
+}
+
+type memoryTable struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func cloneTables(tables map[string]*memoryTable) map[string]*memoryTable {
+	clone := make(map[string]*memoryTable, len(tables))
+	for name, t := range tables {
+		rows := make([][]driver.Value, len(t.rows))
+		copy(rows, t.rows)
+		clone[name] = &memoryTable{columns: t.columns, rows: rows}
+	}
+
+	return clone
+}
+
+func (t *memoryTable) column(name string) int {
+	for i, c := range t.columns {
+		if c == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// memoryConn works on a copy of tables inside a transaction, the copy replaces tables on commit
+type memoryConn struct {
+	db *memoryDB
+	tx map[string]*memoryTable
+}
+
+func (c *memoryConn) Prepare(query string) (driver.Stmt, error) {
+	return &memoryStmt{conn: c, query: strings.Join(strings.Fields(query), " ")}, nil
+}
+
+func (c *memoryConn) Close() error {
+	return nil
+}
+
+func (c *memoryConn) Begin() (driver.Tx, error) {
+	c.db.m.Lock()
+	defer c.db.m.Unlock()
+
+	c.tx = cloneTables(c.db.tables)
+	return c, nil
+}
+
+func (c *memoryConn) Commit() error {
+	c.db.m.Lock()
+	defer c.db.m.Unlock()
+
+	c.db.tables, c.tx = c.tx, nil
+	return nil
+}
+
+func (c *memoryConn) Rollback() error {
+	c.tx = nil
+	return nil
+}
+
+// tables returns tables of the transaction or of the database. The database should be locked
+func (c *memoryConn) tables() map[string]*memoryTable {
+	if c.tx != nil {
+		return c.tx
+	}
+
+	return c.db.tables
+}
+
+type memoryStmt struct {
+	conn  *memoryConn
+	query string
+}
+
+func (s *memoryStmt) Close() error {
+	return nil
+}
+
+func (s *memoryStmt) NumInput() int {
+	return -1
+}
+
+func (s *memoryStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.db.m.Lock()
+	defer s.conn.db.m.Unlock()
+
+	tables := s.conn.tables()
+
+	if strings.HasPrefix(s.query, "CREATE ") {
+		return driver.RowsAffected(0), nil
+	}
+
+	if m := insertStatement.FindStringSubmatch(s.query); m != nil {
+		columns := splitList(m[3])
+		t, ok := tables[m[2]]
+		if !ok {
+			t = &memoryTable{columns: columns}
+			tables[m[2]] = t
+		}
+
+		row := make([]driver.Value, 0, len(columns))
+		for _, v := range splitList(m[4]) {
+			if v == "?" {
+				row = append(row, args[0])
+				args = args[1:]
+				continue
+			}
+
+			literal, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			row = append(row, literal)
+		}
+
+		for i, r := range t.rows {
+			if r[0] != row[0] {
+				continue
+			}
+
+			if m[1] == "" {
+				return nil, fmt.Errorf("%w: %s.%s", errUniqueConstraint, m[2], columns[0])
+			}
+
+			t.rows[i] = row
+			return driver.RowsAffected(1), nil
+		}
+
+		t.rows = append(t.rows, row)
+		return driver.RowsAffected(1), nil
+	}
+
+	if m := deleteStatement.FindStringSubmatch(s.query); m != nil {
+		t, ok := tables[m[1]]
+		if !ok {
+			return driver.RowsAffected(0), nil
+		}
+
+		column := t.column(m[2])
+		kept := t.rows[:0:0]
+		for _, r := range t.rows {
+			if r[column].(int64) <= args[0].(int64) {
+				kept = append(kept, r)
+			}
+		}
+
+		affected := len(t.rows) - len(kept)
+		t.rows = kept
+		return driver.RowsAffected(affected), nil
+	}
+
+	return nil, fmt.Errorf("statement is not supported: %s", s.query)
+}
+
+func (s *memoryStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.conn.db.m.Lock()
+	defer s.conn.db.m.Unlock()
+
+	m := selectStatement.FindStringSubmatch(s.query)
+	if m == nil {
+		return nil, fmt.Errorf("statement is not supported: %s", s.query)
+	}
+
+	columns := splitList(m[1])
+	t, ok := s.conn.tables()[m[2]]
+	if !ok {
+		t = &memoryTable{}
+	}
+
+	var v driver.Value
+	if m[5] == "?" {
+		v, args = args[0], args[1:]
+	} else if m[5] != "" {
+		literal, err := strconv.ParseInt(m[5], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		v = literal
+	}
+
+	matched := make([][]driver.Value, 0, len(t.rows))
+	for _, r := range t.rows {
+		switch {
+		case m[4] == "=" && r[t.column(m[3])] != v:
+			continue
+		case m[4] == "<=" && r[t.column(m[3])].(int64) > v.(int64):
+			continue
+		}
+		matched = append(matched, r)
+	}
+
+	if m[6] != "" {
+		column := t.column(m[6])
+		sort.Slice(matched, func(i, j int) bool {
+			return matched[i][column].(int64) > matched[j][column].(int64)
+		})
+
+		if limit := int(args[0].(int64)); len(matched) > limit {
+			matched = matched[:limit]
+		}
+	}
+
+	if len(columns) == 1 && columns[0] == "COUNT(*)" {
+		return &memoryRows{columns: columns, rows: [][]driver.Value{{int64(len(matched))}}}, nil
+	}
+
+	rows := make([][]driver.Value, len(matched))
+	for i, r := range matched {
+		rows[i] = make([]driver.Value, len(columns))
+		for j, c := range columns {
+			rows[i][j] = r[t.column(c)]
+		}
+	}
+
+	return &memoryRows{columns: columns, rows: rows}, nil
+}
+
+type memoryRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *memoryRows) Columns() []string {
+	return r.columns
+}
+
+func (r *memoryRows) Close() error {
+	return nil
+}
+
+func (r *memoryRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func splitList(list string) []string {
+	items := strings.Split(list, ",")
+	for i := range items {
+		items[i] = strings.TrimSpace(items[i])
+	}
+
+	return items
+}
+
+func newSQLiteFixture(t *testing.T) (*fixture, *sql.DB) {
+	return openSQLiteFixture(t, memoryDriverName, t.Name())
+}
+
+func openSQLiteFixture(t *testing.T, driverName, dataSourceName string) (*fixture, *sql.DB) {
+	f := newFixture(t)
+
+	db, err := sql.Open(driverName, dataSourceName)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	sink, err := NewSQLiteSink(ctx, db)
+	require.NoError(t, err)
+	f.sink, f.checkpoints = sink, sink
+
+	return f, db
+}
+
+func count(t *testing.T, db *sql.DB, query string, args ...interface{}) int {
+	var n int
+	require.NoError(t, db.QueryRow(query, args...).Scan(&n))
+	return n
+}
+
+func TestSQLiteSink_WriteBlock(t *testing.T) {
+	f, db := newSQLiteFixture(t)
+	f.block(t, 2)
+	f.block(t, 1)
+
+	checkpoint, err := f.checkpoints.Load()
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	i := f.indexer()
+	require.NoError(t, i.restore(ctx))
+	require.NoError(t, i.catchUp(ctx))
+
+	assert.Equal(t, 3, count(t, db, `SELECT COUNT(*) FROM blocks`))
+	assert.Equal(t, 3, count(t, db, `SELECT COUNT(*) FROM transactions`))
+	assert.Equal(t, 2, count(t, db, `SELECT COUNT(*) FROM transactions WHERE height = ?`, int64(2)))
+
+	info, err := f.client.Blockchain.GetBlockByHeight(ctx, 3)
+	require.NoError(t, err)
+
+	var hash, previousHash string
+	require.NoError(t, db.QueryRow(`SELECT hash, previous_hash FROM blocks WHERE height = ?`, int64(3)).Scan(&hash, &previousHash))
+	assert.Equal(t, info.BlockHash.String(), hash)
+	assert.Equal(t, info.PreviousBlockHash.String(), previousHash)
+
+	checkpoint, err = f.checkpoints.Load()
+	require.NoError(t, err)
+	assert.Equal(t, &Checkpoint{Height: 3, Hash: hash}, checkpoint)
+
+	// a failed block leaves neither rows nor the checkpoint behind
+	err = f.sink.WriteBlock(ctx, &Block{Info: info})
+	assert.ErrorIs(t, err, errUniqueConstraint)
+	assert.Equal(t, 3, count(t, db, `SELECT COUNT(*) FROM blocks`))
+
+	checkpoint, err = f.checkpoints.Load()
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Height(3), checkpoint.Height)
+}
+
+func TestSQLiteSink_Rollback(t *testing.T) {
+	f, db := newSQLiteFixture(t)
+	f.block(t, 1)
+	f.block(t, 1)
+	f.block(t, 1)
+
+	i := f.indexer()
+	require.NoError(t, i.restore(ctx))
+	require.NoError(t, i.catchUp(ctx))
+	assert.Equal(t, 4, count(t, db, `SELECT COUNT(*) FROM blocks`))
+
+	// the chain drops two blocks and grows on another branch, their transactions are confirmed again at height 3
+	f.node.Rollback(2)
+	f.block(t, 1)
+	f.block(t, 0)
+	f.block(t, 0)
+	require.NoError(t, i.catchUp(ctx))
+
+	assert.Equal(t, 5, count(t, db, `SELECT COUNT(*) FROM blocks`))
+	assert.Equal(t, 4, count(t, db, `SELECT COUNT(*) FROM transactions`))
+	assert.Equal(t, 3, count(t, db, `SELECT COUNT(*) FROM transactions WHERE height = ?`, int64(3)))
+
+	info, err := f.client.Blockchain.GetBlockByHeight(ctx, 5)
+	require.NoError(t, err)
+	checkpoint, err := f.checkpoints.Load()
+	require.NoError(t, err)
+	assert.Equal(t, &Checkpoint{Height: 5, Hash: info.BlockHash.String()}, checkpoint)
+
+	require.NoError(t, f.sink.Rollback(ctx, 2))
+	assert.Equal(t, 2, count(t, db, `SELECT COUNT(*) FROM blocks`))
+	assert.Equal(t, 1, count(t, db, `SELECT COUNT(*) FROM transactions`))
+	assert.Equal(t, 0, count(t, db, `SELECT COUNT(*) FROM blocks WHERE height = ?`, int64(3)))
+}
+
+func TestSQLiteSink_RestartRollback(t *testing.T) {
+	f, db := newSQLiteFixture(t)
+	restartRollback(t, f)
+	assert.Equal(t, 5, count(t, db, `SELECT COUNT(*) FROM blocks`))
+}
@@ -12,9 +12,17 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type LiquidityProviderService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name LiquidityProviderService --output ../mocks --outpkg mocks
 
-func (lp *LiquidityProviderService) GetLiquidityProviders(ctx context.Context, lpOptions *LiquidityProviderPageOptions) (*LiquidityProviderPage, error) {
+// LiquidityProviderService provides liquidity provider REST endpoints
+type LiquidityProviderService interface {
+	GetLiquidityProviders(ctx context.Context, lpOptions *LiquidityProviderPageOptions) (*LiquidityProviderPage, error)
+	GetLiquidityProvider(ctx context.Context, provider *PublicAccount) (*LiquidityProvider, error)
+}
+
+type liquidityProviderService service
+
+func (lp *liquidityProviderService) GetLiquidityProviders(ctx context.Context, lpOptions *LiquidityProviderPageOptions) (*LiquidityProviderPage, error) {
 	lpsDTO := &liquidityProvidersPageDTO{}
 
 	u, err := addOptions(liquidityProvidersRoute, lpOptions)
@@ -34,7 +42,7 @@ func (lp *LiquidityProviderService) GetLiquidityProviders(ctx context.Context, l
 	return lpsDTO.toStruct(lp.client.NetworkType())
 }
 
-func (lp *LiquidityProviderService) GetLiquidityProvider(ctx context.Context, provider *PublicAccount) (*LiquidityProvider, error) {
+func (lp *liquidityProviderService) GetLiquidityProvider(ctx context.Context, provider *PublicAccount) (*LiquidityProvider, error) {
 	if provider == nil {
 		return nil, ErrNilAccount
 	}
@@ -11,9 +11,20 @@ import (
 	"net/http"
 )
 
-type LockService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name LockService --output ../mocks --outpkg mocks
+
+// LockService provides hash and secret lock REST endpoints
+type LockService interface {
+	GetHashLockInfosByAccount(ctx context.Context, account *PublicAccount) ([]*HashLockInfo, error)
+	GetHashLockInfo(ctx context.Context, hash *Hash) (*HashLockInfo, error)
+	GetSecretLockInfosByAccount(ctx context.Context, account *PublicAccount) ([]*SecretLockInfo, error)
+	GetSecretLockInfo(ctx context.Context, compositeHash *Hash) (*SecretLockInfo, error)
+	GetSecretLockInfosBySecret(ctx context.Context, secret *Hash) ([]*SecretLockInfo, error)
+}
+
+type lockService service
 
-func (s *LockService) GetHashLockInfosByAccount(ctx context.Context, account *PublicAccount) ([]*HashLockInfo, error) {
+func (s *lockService) GetHashLockInfosByAccount(ctx context.Context, account *PublicAccount) ([]*HashLockInfo, error) {
 	if account == nil {
 		return nil, ErrNilAddress
 	}
@@ -34,7 +45,7 @@ func (s *LockService) GetHashLockInfosByAccount(ctx context.Context, account *Pu
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *LockService) GetHashLockInfo(ctx context.Context, hash *Hash) (*HashLockInfo, error) {
+func (s *lockService) GetHashLockInfo(ctx context.Context, hash *Hash) (*HashLockInfo, error) {
 	if hash == nil {
 		return nil, ErrNilHash
 	}
@@ -55,7 +66,7 @@ func (s *LockService) GetHashLockInfo(ctx context.Context, hash *Hash) (*HashLoc
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *LockService) GetSecretLockInfosByAccount(ctx context.Context, account *PublicAccount) ([]*SecretLockInfo, error) {
+func (s *lockService) GetSecretLockInfosByAccount(ctx context.Context, account *PublicAccount) ([]*SecretLockInfo, error) {
 	if account == nil {
 		return nil, ErrNilAddress
 	}
@@ -76,7 +87,7 @@ func (s *LockService) GetSecretLockInfosByAccount(ctx context.Context, account *
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *LockService) GetSecretLockInfo(ctx context.Context, compositeHash *Hash) (*SecretLockInfo, error) {
+func (s *lockService) GetSecretLockInfo(ctx context.Context, compositeHash *Hash) (*SecretLockInfo, error) {
 	if compositeHash == nil {
 		return nil, ErrNilHash
 	}
@@ -97,7 +108,7 @@ func (s *LockService) GetSecretLockInfo(ctx context.Context, compositeHash *Hash
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *LockService) GetSecretLockInfosBySecret(ctx context.Context, secret *Hash) ([]*SecretLockInfo, error) {
+func (s *lockService) GetSecretLockInfosBySecret(ctx context.Context, secret *Hash) ([]*SecretLockInfo, error) {
 	if secret == nil {
 		return nil, ErrNilSecret
 	}
@@ -2,7 +2,6 @@ package sdk
 
 import (
 	"encoding/hex"
-	"errors"
 
 	xpxcrypto "github.com/proximax-storage/go-xpx-crypto"
 	"github.com/proximax-storage/go-xpx-utils/str"
@@ -128,12 +127,11 @@ func (m *messageDTO) toStruct() (Message, error) {
 		}
 	}
 
-	switch m.Type {
-	case PlainMessageType:
-		return NewPlainMessage(string(b)), nil
-	case SecureMessageType:
-		return NewSecureMessage(b), nil
-	default:
-		return nil, errors.New("Not supported MessageType")
+	// message types are free-form on the chain, so a payload which the codec rejects is kept as it is
+	message, err := DecodeMessage(m.Type, b)
+	if err != nil {
+		return NewRawMessage(m.Type, b), nil
 	}
+
+	return message, nil
 }
@@ -0,0 +1,453 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	xpxcrypto "github.com/proximax-storage/go-xpx-crypto"
+	"github.com/proximax-storage/go-xpx-utils/str"
+)
+
+// Message types which are interpreted by the sdk. The chain stores their payloads as they are
+const (
+	BinaryMessageType     MessageType = 0x10
+	JSONMessageType       MessageType = 0x11
+	CompressedMessageType MessageType = 0x12
+	// PersistentHarvestingDelegationMessageType asks a node to harvest with the encrypted remote account key
+	PersistentHarvestingDelegationMessageType MessageType = 0xFE
+)
+
+const (
+	// DefaultMaxMessageSize is used when the network config does not define maxMessageSize
+	DefaultMaxMessageSize = 1024
+	// maxMessagePayloadSize is the limit of the message size field of TransferTransaction, including the type byte
+	maxMessagePayloadSize = math.MaxUint16 - 1
+)
+
+// MessageCodec converts values into message payloads of its type and payloads into messages
+type MessageCodec interface {
+	Type() MessageType
+	Encode(v interface{}) (Message, error)
+	Decode(payload []byte) (Message, error)
+}
+
+var messageCodecs = struct {
+	sync.RWMutex
+	codecs map[MessageType]MessageCodec
+}{
+	codecs: map[MessageType]MessageCodec{
+		PlainMessageType:                          plainMessageCodec{},
+		SecureMessageType:                         secureMessageCodec{},
+		BinaryMessageType:                         binaryMessageCodec{},
+		JSONMessageType:                           jsonMessageCodec{},
+		CompressedMessageType:                     compressedMessageCodec{},
+		PersistentHarvestingDelegationMessageType: harvestingDelegationMessageCodec{},
+	},
+}
+
+// RegisterMessageCodec adds the codec of a custom message type. Types can not be registered twice
+func RegisterMessageCodec(codec MessageCodec) error {
+	messageCodecs.Lock()
+	defer messageCodecs.Unlock()
+
+	if _, ok := messageCodecs.codecs[codec.Type()]; ok {
+		return fmt.Errorf("%w: %d", ErrMessageTypeRegistered, codec.Type())
+	}
+
+	messageCodecs.codecs[codec.Type()] = codec
+	return nil
+}
+
+// GetMessageCodec returns the registered codec of the type
+func GetMessageCodec(messageType MessageType) (MessageCodec, bool) {
+	messageCodecs.RLock()
+	defer messageCodecs.RUnlock()
+
+	codec, ok := messageCodecs.codecs[messageType]
+	return codec, ok
+}
+
+// EncodeMessage returns a message of the type with the value encoded by the registered codec
+func EncodeMessage(messageType MessageType, v interface{}) (Message, error) {
+	codec, ok := GetMessageCodec(messageType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownMessageType, messageType)
+	}
+
+	return codec.Encode(v)
+}
+
+// DecodeMessage returns a message decoded by the codec of the type.
+// Payloads of unknown types are returned as RawMessage
+func DecodeMessage(messageType MessageType, payload []byte) (Message, error) {
+	codec, ok := GetMessageCodec(messageType)
+	if !ok {
+		return NewRawMessage(messageType, payload), nil
+	}
+
+	return codec.Decode(payload)
+}
+
+// checkMessageSize returns ErrMessageTooLarge when the message with its type byte does not fit into maxSize
+func checkMessageSize(message Message, maxSize int) error {
+	if message == nil {
+		return nil
+	}
+
+	if size := len(message.Payload()) + 1; size > maxSize {
+		return fmt.Errorf("%w: %d bytes, max %d", ErrMessageTooLarge, size, maxSize)
+	}
+
+	return nil
+}
+
+func bytesOf(v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedMessageValue, v)
+	}
+}
+
+// RawMessage is a message of a type without registered codec
+type RawMessage struct {
+	messageType MessageType
+	payload     []byte
+}
+
+func NewRawMessage(messageType MessageType, payload []byte) *RawMessage {
+	return &RawMessage{messageType, payload}
+}
+
+func (m *RawMessage) String() string {
+	return str.StructToString(
+		"RawMessage",
+		str.NewField("Type", str.IntPattern, m.Type()),
+		str.NewField("Payload", str.StringPattern, fmt.Sprintf("%X", m.Payload())),
+	)
+}
+
+func (m *RawMessage) Type() MessageType {
+	return m.messageType
+}
+
+func (m *RawMessage) Payload() []byte {
+	return m.payload
+}
+
+// BinaryMessage keeps arbitrary bytes which are not converted to a string
+type BinaryMessage struct {
+	payload []byte
+}
+
+func NewBinaryMessage(payload []byte) *BinaryMessage {
+	return &BinaryMessage{payload}
+}
+
+func (m *BinaryMessage) String() string {
+	return str.StructToString(
+		"BinaryMessage",
+		str.NewField("Type", str.IntPattern, m.Type()),
+		str.NewField("Payload", str.StringPattern, fmt.Sprintf("%X", m.Payload())),
+	)
+}
+
+func (m *BinaryMessage) Type() MessageType {
+	return BinaryMessageType
+}
+
+func (m *BinaryMessage) Payload() []byte {
+	return m.payload
+}
+
+// JSONMessage keeps a json document
+type JSONMessage struct {
+	payload []byte
+}
+
+// returns JSONMessage with json encoding of the value
+func NewJSONMessage(v interface{}) (*JSONMessage, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONMessage{payload}, nil
+}
+
+func (m *JSONMessage) String() string {
+	return str.StructToString(
+		"JSONMessage",
+		str.NewField("Type", str.IntPattern, m.Type()),
+		str.NewField("Payload", str.StringPattern, m.Payload()),
+	)
+}
+
+func (m *JSONMessage) Type() MessageType {
+	return JSONMessageType
+}
+
+func (m *JSONMessage) Payload() []byte {
+	return m.payload
+}
+
+// Unmarshal decodes the json document into v. Messages from the chain are not validated,
+// so an invalid document returns ErrInvalidJSONMessage
+func (m *JSONMessage) Unmarshal(v interface{}) error {
+	if !json.Valid(m.payload) {
+		return ErrInvalidJSONMessage
+	}
+
+	return json.Unmarshal(m.payload, v)
+}
+
+// CompressedMessage keeps deflate compressed data
+type CompressedMessage struct {
+	payload []byte
+}
+
+// returns CompressedMessage with compressed data
+func NewCompressedMessage(data []byte) (*CompressedMessage, error) {
+	buf := new(bytes.Buffer)
+	w, err := flate.NewWriter(buf, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return &CompressedMessage{buf.Bytes()}, nil
+}
+
+func (m *CompressedMessage) String() string {
+	return str.StructToString(
+		"CompressedMessage",
+		str.NewField("Type", str.IntPattern, m.Type()),
+		str.NewField("Payload", str.StringPattern, fmt.Sprintf("%X", m.Payload())),
+	)
+}
+
+func (m *CompressedMessage) Type() MessageType {
+	return CompressedMessageType
+}
+
+func (m *CompressedMessage) Payload() []byte {
+	return m.payload
+}
+
+// Data returns decompressed data
+func (m *CompressedMessage) Data() ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(m.payload))
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// PersistentHarvestingDelegationMessage carries the private key of a remote account encrypted for the node,
+// so the node can start harvesting with it. The payload is an ephemeral public key followed by the encrypted key
+type PersistentHarvestingDelegationMessage struct {
+	payload []byte
+}
+
+// returns PersistentHarvestingDelegationMessage with the remote private key encrypted by a new ephemeral key for the node
+func NewPersistentHarvestingDelegationMessage(remotePrivateKey *xpxcrypto.PrivateKey, nodePublicKey *xpxcrypto.PublicKey) (*PersistentHarvestingDelegationMessage, error) {
+	ephemeral, err := xpxcrypto.NewRandomKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := xpxcrypto.NewKeyPair(nil, nodePublicKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := xpxcrypto.NewBlockCipher(ephemeral, node, nil).Encrypt(remotePrivateKey.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PersistentHarvestingDelegationMessage{append(append([]byte{}, ephemeral.PublicKey.Raw...), encrypted...)}, nil
+}
+
+func (m *PersistentHarvestingDelegationMessage) String() string {
+	return str.StructToString(
+		"PersistentHarvestingDelegationMessage",
+		str.NewField("Type", str.IntPattern, m.Type()),
+		str.NewField("Payload", str.StringPattern, fmt.Sprintf("%X", m.Payload())),
+	)
+}
+
+func (m *PersistentHarvestingDelegationMessage) Type() MessageType {
+	return PersistentHarvestingDelegationMessageType
+}
+
+func (m *PersistentHarvestingDelegationMessage) Payload() []byte {
+	return m.payload
+}
+
+// EphemeralPublicKey returns nil when the payload is too short, messages from the chain are not validated
+func (m *PersistentHarvestingDelegationMessage) EphemeralPublicKey() *xpxcrypto.PublicKey {
+	if len(m.payload) <= KeySize {
+		return nil
+	}
+
+	return xpxcrypto.NewPublicKey(m.payload[:KeySize])
+}
+
+// Decrypt returns the remote private key with the private key of the node
+func (m *PersistentHarvestingDelegationMessage) Decrypt(nodePrivateKey *xpxcrypto.PrivateKey) (*xpxcrypto.PrivateKey, error) {
+	if len(m.payload) <= KeySize {
+		return nil, ErrInvalidHarvestingDelegationMessage
+	}
+
+	node, err := xpxcrypto.NewKeyPair(nodePrivateKey, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeral, err := xpxcrypto.NewKeyPair(nil, m.EphemeralPublicKey(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := xpxcrypto.NewBlockCipher(ephemeral, node, nil).Decrypt(m.payload[KeySize:])
+	if err != nil {
+		return nil, err
+	}
+
+	return xpxcrypto.NewPrivateKey(raw), nil
+}
+
+type plainMessageCodec struct{}
+
+func (plainMessageCodec) Type() MessageType {
+	return PlainMessageType
+}
+
+func (plainMessageCodec) Encode(v interface{}) (Message, error) {
+	b, err := bytesOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPlainMessage(string(b)), nil
+}
+
+func (plainMessageCodec) Decode(payload []byte) (Message, error) {
+	return NewPlainMessage(string(payload)), nil
+}
+
+type secureMessageCodec struct{}
+
+func (secureMessageCodec) Type() MessageType {
+	return SecureMessageType
+}
+
+// Encode expects already encrypted data, see NewSecureMessageFromPlaintText
+func (secureMessageCodec) Encode(v interface{}) (Message, error) {
+	b, err := bytesOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSecureMessage(b), nil
+}
+
+func (secureMessageCodec) Decode(payload []byte) (Message, error) {
+	return NewSecureMessage(payload), nil
+}
+
+type binaryMessageCodec struct{}
+
+func (binaryMessageCodec) Type() MessageType {
+	return BinaryMessageType
+}
+
+func (binaryMessageCodec) Encode(v interface{}) (Message, error) {
+	b, err := bytesOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBinaryMessage(b), nil
+}
+
+func (binaryMessageCodec) Decode(payload []byte) (Message, error) {
+	return NewBinaryMessage(payload), nil
+}
+
+type jsonMessageCodec struct{}
+
+func (jsonMessageCodec) Type() MessageType {
+	return JSONMessageType
+}
+
+func (jsonMessageCodec) Encode(v interface{}) (Message, error) {
+	return NewJSONMessage(v)
+}
+
+// Decode does not validate the payload, the chain accepts any bytes. See JSONMessage.Unmarshal
+func (jsonMessageCodec) Decode(payload []byte) (Message, error) {
+	return &JSONMessage{payload}, nil
+}
+
+type compressedMessageCodec struct{}
+
+func (compressedMessageCodec) Type() MessageType {
+	return CompressedMessageType
+}
+
+func (compressedMessageCodec) Encode(v interface{}) (Message, error) {
+	b, err := bytesOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCompressedMessage(b)
+}
+
+func (compressedMessageCodec) Decode(payload []byte) (Message, error) {
+	return &CompressedMessage{payload}, nil
+}
+
+type harvestingDelegationMessageCodec struct{}
+
+func (harvestingDelegationMessageCodec) Type() MessageType {
+	return PersistentHarvestingDelegationMessageType
+}
+
+// Encode expects the payload of a message built by NewPersistentHarvestingDelegationMessage
+func (c harvestingDelegationMessageCodec) Encode(v interface{}) (Message, error) {
+	b, err := bytesOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) <= KeySize {
+		return nil, ErrInvalidHarvestingDelegationMessage
+	}
+
+	return c.Decode(b)
+}
+
+// Decode does not validate the payload, the chain accepts any bytes. See PersistentHarvestingDelegationMessage.Decrypt
+func (harvestingDelegationMessageCodec) Decode(payload []byte) (Message, error) {
+	return &PersistentHarvestingDelegationMessage{payload}, nil
+}
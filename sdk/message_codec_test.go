@@ -0,0 +1,197 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	crypto "github.com/proximax-storage/go-xpx-crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNetworkConfigService struct {
+	NetworkService
+	config string
+}
+
+func (s *fakeNetworkConfigService) GetNetworkConfig(ctx context.Context) (*BlockchainConfig, error) {
+	c := NewNetworkConfig()
+	if err := c.UnmarshalBinary([]byte(s.config)); err != nil {
+		return nil, err
+	}
+
+	return &BlockchainConfig{NetworkConfig: c}, nil
+}
+
+type reversedMessageCodec struct{}
+
+func (reversedMessageCodec) Type() MessageType {
+	return 0x40
+}
+
+func (c reversedMessageCodec) Encode(v interface{}) (Message, error) {
+	b, err := bytesOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	reversed := make([]byte, len(b))
+	for i := range b {
+		reversed[len(b)-1-i] = b[i]
+	}
+
+	return NewRawMessage(c.Type(), reversed), nil
+}
+
+func (c reversedMessageCodec) Decode(payload []byte) (Message, error) {
+	return NewRawMessage(c.Type(), payload), nil
+}
+
+type failingMessageCodec struct{}
+
+func (failingMessageCodec) Type() MessageType {
+	return 0x42
+}
+
+func (failingMessageCodec) Encode(interface{}) (Message, error) {
+	return nil, ErrUnsupportedMessageValue
+}
+
+func (failingMessageCodec) Decode([]byte) (Message, error) {
+	return nil, ErrUnsupportedMessageValue
+}
+
+func TestMessageDTO_toStruct(t *testing.T) {
+	jsonMessage, err := NewJSONMessage(map[string]int{"amount": 10})
+	require.NoError(t, err)
+	compressed, err := NewCompressedMessage([]byte(strings.Repeat("xpx", 100)))
+	require.NoError(t, err)
+
+	for _, message := range []Message{
+		NewPlainMessage("plain"),
+		NewSecureMessage([]byte{1, 2, 3}),
+		NewBinaryMessage([]byte{0, 0xFF, 0x10}),
+		jsonMessage,
+		compressed,
+		NewRawMessage(0x33, []byte{4, 5}),
+	} {
+		dto := &messageDTO{Type: message.Type(), Payload: hex.EncodeToString(message.Payload())}
+
+		decoded, err := dto.toStruct()
+		require.NoError(t, err)
+		assert.IsType(t, message, decoded)
+		assert.Equal(t, message.Type(), decoded.Type())
+		assert.Equal(t, message.Payload(), decoded.Payload())
+	}
+
+	data, err := compressed.Data()
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("xpx", 100), string(data))
+
+	v := make(map[string]int)
+	require.NoError(t, jsonMessage.Unmarshal(&v))
+	assert.Equal(t, 10, v["amount"])
+
+	// messages from the chain are validated by accessors
+	decoded, err := (&messageDTO{Type: JSONMessageType, Payload: hex.EncodeToString([]byte("{"))}).toStruct()
+	require.NoError(t, err)
+	require.IsType(t, &JSONMessage{}, decoded)
+	assert.Equal(t, ErrInvalidJSONMessage, decoded.(*JSONMessage).Unmarshal(&v))
+
+	// payloads rejected by the codec are kept as RawMessage
+	require.NoError(t, RegisterMessageCodec(failingMessageCodec{}))
+	decoded, err = (&messageDTO{Type: 0x42, Payload: "0102"}).toStruct()
+	require.NoError(t, err)
+	assert.Equal(t, NewRawMessage(0x42, []byte{1, 2}), decoded)
+}
+
+func TestRegisterMessageCodec(t *testing.T) {
+	require.NoError(t, RegisterMessageCodec(reversedMessageCodec{}))
+	assert.True(t, errors.Is(RegisterMessageCodec(reversedMessageCodec{}), ErrMessageTypeRegistered))
+
+	message, err := EncodeMessage(0x40, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("cba"), message.Payload())
+
+	_, err = EncodeMessage(0x41, "abc")
+	assert.True(t, errors.Is(err, ErrUnknownMessageType))
+
+	_, err = EncodeMessage(BinaryMessageType, 42)
+	assert.True(t, errors.Is(err, ErrUnsupportedMessageValue))
+}
+
+func TestPersistentHarvestingDelegationMessage(t *testing.T) {
+	remote, err := crypto.NewRandomKeyPair()
+	require.NoError(t, err)
+	node, err := crypto.NewRandomKeyPair()
+	require.NoError(t, err)
+
+	message, err := NewPersistentHarvestingDelegationMessage(remote.PrivateKey, node.PublicKey)
+	require.NoError(t, err)
+
+	dto := &messageDTO{Type: message.Type(), Payload: hex.EncodeToString(message.Payload())}
+	decoded, err := dto.toStruct()
+	require.NoError(t, err)
+
+	delegation, ok := decoded.(*PersistentHarvestingDelegationMessage)
+	require.True(t, ok)
+
+	privateKey, err := delegation.Decrypt(node.PrivateKey)
+	require.NoError(t, err)
+	assert.Equal(t, remote.PrivateKey.String(), privateKey.String())
+
+	_, err = EncodeMessage(PersistentHarvestingDelegationMessageType, []byte{1})
+	assert.Equal(t, ErrInvalidHarvestingDelegationMessage, err)
+
+	short, err := DecodeMessage(PersistentHarvestingDelegationMessageType, []byte{1})
+	require.NoError(t, err)
+	assert.Nil(t, short.(*PersistentHarvestingDelegationMessage).EphemeralPublicKey())
+	_, err = short.(*PersistentHarvestingDelegationMessage).Decrypt(node.PrivateKey)
+	assert.Equal(t, ErrInvalidHarvestingDelegationMessage, err)
+}
+
+func TestClient_NewTransferTransaction_MaxMessageSize(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+	assert.Equal(t, 0, config.MaxMessageSize)
+
+	recipient, err := NewAddressFromRaw("VAWOEOWTABXR7O3ZAK2XNA5GIBNE6PZIXDAFDWBU")
+	require.NoError(t, err)
+
+	// without the network config only the message size field of the transaction limits the message
+	client := NewClient(nil, config)
+	_, err = client.NewTransferTransaction(NewDeadline(time.Hour), recipient, []*Mosaic{}, NewBinaryMessage(make([]byte, DefaultMaxMessageSize*4)))
+	assert.NoError(t, err)
+
+	_, err = client.NewTransferTransaction(NewDeadline(time.Hour), recipient, []*Mosaic{}, NewBinaryMessage(make([]byte, 1<<16)))
+	assert.True(t, errors.Is(err, ErrMessageTooLarge))
+
+	client.Network = &fakeNetworkConfigService{config: "[plugin:catapult.plugins.transfer]\n\nmaxMessageSize = 8\n"}
+
+	config.MaxMessageSize, err = client.MaxMessageSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 8, config.MaxMessageSize)
+
+	_, err = client.NewTransferTransaction(NewDeadline(time.Hour), recipient, []*Mosaic{}, NewPlainMessage("1234567"))
+	assert.NoError(t, err)
+
+	_, err = client.NewTransferTransaction(NewDeadline(time.Hour), recipient, []*Mosaic{}, NewPlainMessage("12345678"))
+	assert.True(t, errors.Is(err, ErrMessageTooLarge))
+
+	// the message size field of the transaction is the limit without a client
+	_, err = NewTransferTransaction(NewDeadline(time.Hour), recipient, []*Mosaic{}, NewBinaryMessage(make([]byte, 1<<16)), PublicTest)
+	assert.True(t, errors.Is(err, ErrMessageTooLarge))
+
+	client.Network = &fakeNetworkConfigService{config: "[chain]\n\nblockGenerationTargetTime = 15s\n"}
+	maxMessageSize, err := client.MaxMessageSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMaxMessageSize, maxMessageSize)
+}
@@ -0,0 +1,271 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"strings"
+
+	crypto "github.com/proximax-storage/go-xpx-crypto"
+)
+
+const (
+	secureMessageSaltSize = 32
+	secureMessageIvSize   = aes.BlockSize
+)
+
+// KeyAgreement derives keys shared with other accounts. Implementations can keep the private key
+// in a remote signer or HSM
+type KeyAgreement interface {
+	PublicKey() *crypto.PublicKey
+	// SharedKey returns the key shared with the owner of publicKey for the salt of a secure message
+	SharedKey(ctx context.Context, publicKey *crypto.PublicKey, salt []byte) ([]byte, error)
+}
+
+type keyPairAgreement struct {
+	keyPair *crypto.KeyPair
+}
+
+// returns KeyAgreement which derives shared keys with the private key of keyPair
+func NewKeyPairAgreement(keyPair *crypto.KeyPair) KeyAgreement {
+	return &keyPairAgreement{keyPair: keyPair}
+}
+
+func (a *keyPairAgreement) PublicKey() *crypto.PublicKey {
+	return a.keyPair.PublicKey
+}
+
+func (a *keyPairAgreement) SharedKey(_ context.Context, publicKey *crypto.PublicKey, salt []byte) ([]byte, error) {
+	if len(salt) != secureMessageSaltSize {
+		return nil, ErrInvalidSecureMessage
+	}
+
+	return crypto.NewEd25519BlockCipher(a.keyPair, a.keyPair, nil).GetSharedKey(a.keyPair.PrivateKey, publicKey, salt)
+}
+
+// DecryptedMessage is a secure message of a transfer decrypted by MessageCrypto
+type DecryptedMessage struct {
+	Transfer *TransferTransaction
+	// Parent is the aggregate transaction of inner transfers
+	Parent  *AggregateTransaction
+	Message *PlainMessage
+	Err     error
+}
+
+// MessageCrypto encrypts and decrypts secure messages of the account behind KeyAgreement
+type MessageCrypto struct {
+	agreement   KeyAgreement
+	networkType NetworkType
+	// rand is the source of salts and initialization vectors
+	rand io.Reader
+}
+
+// returns MessageCrypto of the account of agreement in the network
+func NewMessageCrypto(agreement KeyAgreement, networkType NetworkType) *MessageCrypto {
+	return &MessageCrypto{
+		agreement:   agreement,
+		networkType: networkType,
+		rand:        rand.Reader,
+	}
+}
+
+// PublicAccount returns the account which messages are encrypted and decrypted
+func (c *MessageCrypto) PublicAccount() (*PublicAccount, error) {
+	return NewAccountFromPublicKey(c.agreement.PublicKey().String(), c.networkType)
+}
+
+// Encrypt returns a secure message of plainText which only the recipient and the account can read
+func (c *MessageCrypto) Encrypt(ctx context.Context, plainText []byte, recipient *PublicAccount) (*SecureMessage, error) {
+	publicKey, err := crypto.NewPublicKeyfromHex(recipient.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, secureMessageSaltSize)
+	if _, err := io.ReadFull(c.rand, salt); err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, secureMessageIvSize)
+	if _, err := io.ReadFull(c.rand, iv); err != nil {
+		return nil, err
+	}
+
+	block, err := c.block(ctx, publicKey, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	padding := block.BlockSize() - len(plainText)%block.BlockSize()
+	padded := make([]byte, len(plainText)+padding)
+	copy(padded, plainText)
+	for i := len(plainText); i < len(padded); i++ {
+		padded[i] = byte(padding)
+	}
+
+	encrypted := make([]byte, 0, len(salt)+len(iv)+len(padded))
+	encrypted = append(append(encrypted, salt...), iv...)
+	encrypted = encrypted[:len(encrypted)+len(padded)]
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted[len(salt)+len(iv):], padded)
+
+	return NewSecureMessage(encrypted), nil
+}
+
+// Decrypt returns the plain text of a secure message exchanged with the counterparty
+func (c *MessageCrypto) Decrypt(ctx context.Context, message *SecureMessage, counterparty *PublicAccount) (*PlainMessage, error) {
+	publicKey, err := crypto.NewPublicKeyfromHex(counterparty.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := message.Payload()
+	if len(payload) < secureMessageSaltSize+secureMessageIvSize+aes.BlockSize ||
+		(len(payload)-secureMessageSaltSize-secureMessageIvSize)%aes.BlockSize != 0 {
+		return nil, ErrInvalidSecureMessage
+	}
+
+	salt := payload[:secureMessageSaltSize]
+	iv := payload[secureMessageSaltSize : secureMessageSaltSize+secureMessageIvSize]
+	encrypted := payload[secureMessageSaltSize+secureMessageIvSize:]
+
+	block, err := c.block(ctx, publicKey, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plain := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, encrypted)
+
+	padding := int(plain[len(plain)-1])
+	if padding == 0 || padding > block.BlockSize() {
+		return nil, ErrSecureMessageCorrupted
+	}
+
+	for _, b := range plain[len(plain)-padding:] {
+		if int(b) != padding {
+			return nil, ErrSecureMessageCorrupted
+		}
+	}
+
+	return &PlainMessage{payload: plain[:len(plain)-padding]}, nil
+}
+
+// Reencrypt decrypts a secure message received from the sender and encrypts it for the recipient to forward it
+func (c *MessageCrypto) Reencrypt(ctx context.Context, message *SecureMessage, sender, recipient *PublicAccount) (*SecureMessage, error) {
+	plain, err := c.Decrypt(ctx, message, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Encrypt(ctx, plain.Payload(), recipient)
+}
+
+// DecryptPage decrypts secure messages of transfers in the page, including inner transfers of aggregates,
+// which are received by the account. Failures are reported by DecryptedMessage.Err
+func (c *MessageCrypto) DecryptPage(ctx context.Context, page *TransactionsPage) ([]*DecryptedMessage, error) {
+	account, err := c.PublicAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*DecryptedMessage, 0)
+	for _, tx := range page.Transactions {
+		res = append(res, c.decryptTransaction(ctx, account, tx)...)
+	}
+
+	return res, nil
+}
+
+// DecryptStream decrypts secure messages of received transfers read from txs until it is closed
+// or ctx is done. The returned channel is closed after the last message
+func (c *MessageCrypto) DecryptStream(ctx context.Context, txs <-chan Transaction) (<-chan *DecryptedMessage, error) {
+	account, err := c.PublicAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *DecryptedMessage)
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case tx, ok := <-txs:
+				if !ok {
+					return
+				}
+
+				for _, m := range c.decryptTransaction(ctx, account, tx) {
+					select {
+					case out <- m:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (c *MessageCrypto) decryptTransaction(ctx context.Context, account *PublicAccount, tx Transaction) []*DecryptedMessage {
+	switch tx := tx.(type) {
+	case *TransferTransaction:
+		if m := c.decryptTransfer(ctx, account, tx); m != nil {
+			return []*DecryptedMessage{m}
+		}
+	case *AggregateTransaction:
+		res := make([]*DecryptedMessage, 0)
+		for _, inner := range tx.InnerTransactions {
+			transfer, ok := inner.(*TransferTransaction)
+			if !ok {
+				continue
+			}
+
+			if m := c.decryptTransfer(ctx, account, transfer); m != nil {
+				m.Parent = tx
+				res = append(res, m)
+			}
+		}
+
+		return res
+	}
+
+	return nil
+}
+
+// decryptTransfer returns nil when the transfer has no secure message or is not received by the account.
+// Transfers to namespaces are decrypted, because the alias can not be resolved here
+func (c *MessageCrypto) decryptTransfer(ctx context.Context, account *PublicAccount, tx *TransferTransaction) *DecryptedMessage {
+	message, ok := tx.Message.(*SecureMessage)
+	if !ok || tx.Recipient == nil || tx.Signer == nil || strings.EqualFold(tx.Signer.PublicKey, account.PublicKey) {
+		return nil
+	}
+
+	if tx.Recipient.Type != AliasAddress && tx.Recipient.Address != account.Address.Address {
+		return nil
+	}
+
+	m := &DecryptedMessage{Transfer: tx}
+	m.Message, m.Err = c.Decrypt(ctx, message, tx.Signer)
+
+	return m
+}
+
+func (c *MessageCrypto) block(ctx context.Context, publicKey *crypto.PublicKey, salt []byte) (cipher.Block, error) {
+	key, err := c.agreement.SharedKey(ctx, publicKey, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return aes.NewCipher(key)
+}
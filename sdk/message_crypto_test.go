@@ -0,0 +1,162 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	crypto "github.com/proximax-storage/go-xpx-crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteAgreement is KeyAgreement of a signer which does not expose the private key
+type remoteAgreement struct {
+	agreement KeyAgreement
+	calls     int
+}
+
+func (a *remoteAgreement) PublicKey() *crypto.PublicKey {
+	return a.agreement.PublicKey()
+}
+
+func (a *remoteAgreement) SharedKey(ctx context.Context, publicKey *crypto.PublicKey, salt []byte) ([]byte, error) {
+	a.calls++
+	return a.agreement.SharedKey(ctx, publicKey, salt)
+}
+
+func newMessageCryptoT(t *testing.T) (*Account, *remoteAgreement, *MessageCrypto) {
+	account, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	agreement := &remoteAgreement{agreement: NewKeyPairAgreement(account.KeyPair)}
+
+	return account, agreement, NewMessageCrypto(agreement, PublicTest)
+}
+
+func secureTransferT(t *testing.T, sender *Account, recipient *Address, message Message) *TransferTransaction {
+	tx, err := NewTransferTransaction(NewDeadline(time.Hour), recipient, []*Mosaic{}, message, PublicTest)
+	require.NoError(t, err)
+	tx.Signer = sender.PublicAccount
+
+	return tx
+}
+
+func TestMessageCrypto_Encrypt(t *testing.T) {
+	account, agreement, messageCrypto := newMessageCryptoT(t)
+	recipient, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	secure, err := messageCrypto.Encrypt(ctx, []byte("hello"), recipient.PublicAccount)
+	require.NoError(t, err)
+	assert.Equal(t, 1, agreement.calls)
+
+	// messages are compatible with the ones of the account
+	plain, err := recipient.DecryptMessage(secure, account.PublicAccount)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", plain.Message())
+
+	secure, err = recipient.EncryptMessage("reply", account.PublicAccount)
+	require.NoError(t, err)
+	plain, err = messageCrypto.Decrypt(ctx, secure, recipient.PublicAccount)
+	require.NoError(t, err)
+	assert.Equal(t, "reply", plain.Message())
+
+	_, err = messageCrypto.Decrypt(ctx, NewSecureMessage([]byte{1, 2, 3}), recipient.PublicAccount)
+	assert.Equal(t, ErrInvalidSecureMessage, err)
+}
+
+func TestMessageCrypto_Reencrypt(t *testing.T) {
+	_, _, messageCrypto := newMessageCryptoT(t)
+	sender, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	recipient, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	account, err := messageCrypto.PublicAccount()
+	require.NoError(t, err)
+	secure, err := sender.EncryptMessage("forward me", account)
+	require.NoError(t, err)
+
+	forwarded, err := messageCrypto.Reencrypt(ctx, secure, sender.PublicAccount, recipient.PublicAccount)
+	require.NoError(t, err)
+	assert.NotEqual(t, secure.Payload(), forwarded.Payload())
+
+	plain, err := recipient.DecryptMessage(forwarded, account)
+	require.NoError(t, err)
+	assert.Equal(t, "forward me", plain.Message())
+}
+
+func TestMessageCrypto_DecryptPage(t *testing.T) {
+	account, _, messageCrypto := newMessageCryptoT(t)
+	sender, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	other, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	first, err := sender.EncryptMessage("first", account.PublicAccount)
+	require.NoError(t, err)
+	second, err := sender.EncryptMessage("second", account.PublicAccount)
+	require.NoError(t, err)
+	toOther, err := sender.EncryptMessage("other", other.PublicAccount)
+	require.NoError(t, err)
+
+	corrupted := first.Payload()[:len(first.Payload())-1]
+
+	inner := secureTransferT(t, sender, account.Address, second)
+	aggregate, err := NewCompleteAggregateTransaction(NewDeadline(time.Hour), []Transaction{inner}, PublicTest)
+	require.NoError(t, err)
+
+	page := &TransactionsPage{Transactions: []Transaction{
+		secureTransferT(t, sender, account.Address, first),
+		secureTransferT(t, sender, other.Address, toOther),
+		secureTransferT(t, sender, account.Address, NewPlainMessage("plain")),
+		secureTransferT(t, account, sender.Address, first),
+		secureTransferT(t, sender, account.Address, NewSecureMessage(corrupted)),
+		aggregate,
+	}}
+
+	messages, err := messageCrypto.DecryptPage(ctx, page)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+
+	assert.NoError(t, messages[0].Err)
+	assert.Equal(t, "first", messages[0].Message.Message())
+	assert.Equal(t, page.Transactions[0], messages[0].Transfer)
+
+	assert.Nil(t, messages[1].Message)
+	assert.Equal(t, ErrInvalidSecureMessage, messages[1].Err)
+
+	assert.NoError(t, messages[2].Err)
+	assert.Equal(t, "second", messages[2].Message.Message())
+	assert.Equal(t, inner, messages[2].Transfer)
+	assert.Equal(t, aggregate, messages[2].Parent)
+}
+
+func TestMessageCrypto_DecryptStream(t *testing.T) {
+	account, _, messageCrypto := newMessageCryptoT(t)
+	sender, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	txs := make(chan Transaction, 2)
+	for _, text := range []string{"first", "second"} {
+		secure, err := sender.EncryptMessage(text, account.PublicAccount)
+		require.NoError(t, err)
+		txs <- secureTransferT(t, sender, account.Address, secure)
+	}
+	close(txs)
+
+	messages, err := messageCrypto.DecryptStream(ctx, txs)
+	require.NoError(t, err)
+
+	texts := make([]string, 0)
+	for m := range messages {
+		require.NoError(t, m.Err)
+		texts = append(texts, m.Message.Message())
+	}
+	assert.Equal(t, []string{"first", "second"}, texts)
+}
@@ -9,9 +9,21 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type MetadataService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name MetadataService --output ../mocks --outpkg mocks
+
+// MetadataService provides metadata REST endpoints
+type MetadataService interface {
+	GetAddressMetadatasInfo(ctx context.Context, addresses ...string) ([]*AddressMetadataInfo, error)
+	GetMosaicMetadatasInfo(ctx context.Context, mosaicIds ...*MosaicId) ([]*MosaicMetadataInfo, error)
+	GetNamespaceMetadatasInfo(ctx context.Context, namespaceIds ...*NamespaceId) ([]*NamespaceMetadataInfo, error)
+	GetMetadataByAddress(ctx context.Context, address string) (*AddressMetadataInfo, error)
+	GetMetadataByMosaicId(ctx context.Context, mosaicId *MosaicId) (*MosaicMetadataInfo, error)
+	GetMetadataByNamespaceId(ctx context.Context, namespaceId *NamespaceId) (*NamespaceMetadataInfo, error)
+}
+
+type metadataService service
 
-func (ref *MetadataService) GetAddressMetadatasInfo(ctx context.Context, addresses ...string) ([]*AddressMetadataInfo, error) {
+func (ref *metadataService) GetAddressMetadatasInfo(ctx context.Context, addresses ...string) ([]*AddressMetadataInfo, error) {
 	if len(addresses) == 0 {
 		return nil, ErrMetadataEmptyAddresses
 	}
@@ -41,7 +53,7 @@ func (ref *MetadataService) GetAddressMetadatasInfo(ctx context.Context, address
 	return infos, nil
 }
 
-func (ref *MetadataService) GetMosaicMetadatasInfo(ctx context.Context, mosaicIds ...*MosaicId) ([]*MosaicMetadataInfo, error) {
+func (ref *metadataService) GetMosaicMetadatasInfo(ctx context.Context, mosaicIds ...*MosaicId) ([]*MosaicMetadataInfo, error) {
 	if len(mosaicIds) == 0 {
 		return nil, ErrMetadataEmptyMosaicIds
 	}
@@ -75,7 +87,7 @@ func (ref *MetadataService) GetMosaicMetadatasInfo(ctx context.Context, mosaicId
 	return infos, nil
 }
 
-func (ref *MetadataService) GetNamespaceMetadatasInfo(ctx context.Context, namespaceIds ...*NamespaceId) ([]*NamespaceMetadataInfo, error) {
+func (ref *metadataService) GetNamespaceMetadatasInfo(ctx context.Context, namespaceIds ...*NamespaceId) ([]*NamespaceMetadataInfo, error) {
 	if len(namespaceIds) == 0 {
 		return nil, ErrMetadataEmptyNamespaceIds
 	}
@@ -109,7 +121,7 @@ func (ref *MetadataService) GetNamespaceMetadatasInfo(ctx context.Context, names
 	return infos, nil
 }
 
-func (ref *MetadataService) GetMetadataByAddress(ctx context.Context, address string) (*AddressMetadataInfo, error) {
+func (ref *metadataService) GetMetadataByAddress(ctx context.Context, address string) (*AddressMetadataInfo, error) {
 	if len(address) == 0 {
 		return nil, ErrMetadataNilAdress
 	}
@@ -142,7 +154,7 @@ func (ref *MetadataService) GetMetadataByAddress(ctx context.Context, address st
 	return info, nil
 }
 
-func (ref *MetadataService) GetMetadataByMosaicId(ctx context.Context, mosaicId *MosaicId) (*MosaicMetadataInfo, error) {
+func (ref *metadataService) GetMetadataByMosaicId(ctx context.Context, mosaicId *MosaicId) (*MosaicMetadataInfo, error) {
 	if mosaicId == nil {
 		return nil, ErrMetadataNilMosaicId
 	}
@@ -170,7 +182,7 @@ func (ref *MetadataService) GetMetadataByMosaicId(ctx context.Context, mosaicId
 	return info, nil
 }
 
-func (ref *MetadataService) GetMetadataByNamespaceId(ctx context.Context, namespaceId *NamespaceId) (*NamespaceMetadataInfo, error) {
+func (ref *metadataService) GetMetadataByNamespaceId(ctx context.Context, namespaceId *NamespaceId) (*NamespaceMetadataInfo, error) {
 	if namespaceId == nil {
 		return nil, ErrMetadataNilNamespaceId
 	}
@@ -198,7 +210,7 @@ func (ref *MetadataService) GetMetadataByNamespaceId(ctx context.Context, namesp
 	return info, nil
 }
 
-func (ref *MetadataService) getMetadata(ctx context.Context, url *net.Url, dto interface{}) error {
+func (ref *metadataService) getMetadata(ctx context.Context, url *net.Url, dto interface{}) error {
 	resp, err := ref.client.doNewRequest(ctx, http.MethodGet, url.Encode(), nil, dto)
 	if err != nil {
 		switch e := err.(type) {
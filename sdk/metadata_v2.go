@@ -11,9 +11,18 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type MetadataV2Service service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name MetadataV2Service --output ../mocks --outpkg mocks
 
-func (ref *MetadataV2Service) GetMetadataV2Info(ctx context.Context, computedHash *Hash) (*MetadataV2TupleInfo, error) {
+// MetadataV2Service provides metadata v2 REST endpoints
+type MetadataV2Service interface {
+	GetMetadataV2Info(ctx context.Context, computedHash *Hash) (*MetadataV2TupleInfo, error)
+	GetMetadataV2InfosByHashes(ctx context.Context, hashes []*Hash) ([]*MetadataV2TupleInfo, error)
+	GetMetadataV2Infos(ctx context.Context, mOpts *MetadataV2PageOptions) (*MetadatasPage, error)
+}
+
+type metadataV2Service service
+
+func (ref *metadataV2Service) GetMetadataV2Info(ctx context.Context, computedHash *Hash) (*MetadataV2TupleInfo, error) {
 	if computedHash == nil {
 		return nil, ErrNilHash
 	}
@@ -39,7 +48,7 @@ func (ref *MetadataV2Service) GetMetadataV2Info(ctx context.Context, computedHas
 	return mscInfo, nil
 }
 
-func (ref *MetadataV2Service) GetMetadataV2InfosByHashes(ctx context.Context, hashes []*Hash) ([]*MetadataV2TupleInfo, error) {
+func (ref *metadataV2Service) GetMetadataV2InfosByHashes(ctx context.Context, hashes []*Hash) ([]*MetadataV2TupleInfo, error) {
 	if len(hashes) == 0 {
 		return nil, ErrNilHashes
 	}
@@ -58,7 +67,7 @@ func (ref *MetadataV2Service) GetMetadataV2InfosByHashes(ctx context.Context, ha
 	return dtos.toStruct(ref.client.config.NetworkType)
 }
 
-func (ref *MetadataV2Service) GetMetadataV2Infos(ctx context.Context, mOpts *MetadataV2PageOptions) (*MetadatasPage, error) {
+func (ref *metadataV2Service) GetMetadataV2Infos(ctx context.Context, mOpts *MetadataV2PageOptions) (*MetadatasPage, error) {
 	dtos := &metadatasPageDTO{}
 
 	u, err := addOptions(metadataEntriesRoute, mOpts)
@@ -12,9 +12,23 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type MosaicService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name MosaicService --output ../mocks --outpkg mocks
+
+// MosaicService provides mosaic REST endpoints
+type MosaicService interface {
+	GetMosaicInfo(ctx context.Context, mosaicId *MosaicId) (*MosaicInfo, error)
+	GetMosaicInfos(ctx context.Context, mscIds []*MosaicId) ([]*MosaicInfo, error)
+	// GetMosaicsNames Get readable names for a set of mosaics
+	// post @/mosaic/names
+	GetMosaicsNames(ctx context.Context, mscIds ...*MosaicId) ([]*MosaicName, error)
+	// GetMosaicLevy returns mosaic levy
+	// get @/mosaic/%s/levy
+	GetMosaicLevy(ctx context.Context, mosaicId *MosaicId) (*MosaicLevy, error)
+}
+
+type mosaicService service
 
-func (ref *MosaicService) GetMosaicInfo(ctx context.Context, mosaicId *MosaicId) (*MosaicInfo, error) {
+func (ref *mosaicService) GetMosaicInfo(ctx context.Context, mosaicId *MosaicId) (*MosaicInfo, error) {
 	if mosaicId == nil {
 		return nil, ErrNilMosaicId
 	}
@@ -40,7 +54,7 @@ func (ref *MosaicService) GetMosaicInfo(ctx context.Context, mosaicId *MosaicId)
 	return mscInfo, nil
 }
 
-func (ref *MosaicService) GetMosaicInfos(ctx context.Context, mscIds []*MosaicId) ([]*MosaicInfo, error) {
+func (ref *mosaicService) GetMosaicInfos(ctx context.Context, mscIds []*MosaicId) ([]*MosaicInfo, error) {
 	if len(mscIds) == 0 {
 		return nil, ErrEmptyMosaicIds
 	}
@@ -66,7 +80,7 @@ func (ref *MosaicService) GetMosaicInfos(ctx context.Context, mscIds []*MosaicId
 
 // GetMosaicsNames Get readable names for a set of mosaics
 // post @/mosaic/names
-func (ref *MosaicService) GetMosaicsNames(ctx context.Context, mscIds ...*MosaicId) ([]*MosaicName, error) {
+func (ref *mosaicService) GetMosaicsNames(ctx context.Context, mscIds ...*MosaicId) ([]*MosaicName, error) {
 	if len(mscIds) == 0 {
 		return nil, ErrEmptyMosaicIds
 	}
@@ -87,7 +101,7 @@ func (ref *MosaicService) GetMosaicsNames(ctx context.Context, mscIds ...*Mosaic
 
 // GetMosaicLevy returns mosaic levy
 // get @/mosaic/%s/levy
-func (ref *MosaicService) GetMosaicLevy(ctx context.Context, mosaicId *MosaicId) (*MosaicLevy, error) {
+func (ref *mosaicService) GetMosaicLevy(ctx context.Context, mosaicId *MosaicId) (*MosaicLevy, error) {
 	if mosaicId == nil {
 		return nil, ErrNilMosaicId
 	}
@@ -0,0 +1,381 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultMaxMosaicDivisibility              = 6
+	DefaultMaxMosaicDivisibleUnits     Amount = 9000000000000000
+	DefaultMaxMosaicDuration                  = time.Hour * 24 * 3650
+	DefaultNamespaceRegistrationPeriod        = time.Hour * 24 * 365
+)
+
+// MosaicLimits are limits of mosaics in the network
+type MosaicLimits struct {
+	MaxDivisibility   uint8
+	MaxDivisibleUnits Amount
+	// MaxDuration is in blocks
+	MaxDuration Duration
+}
+
+// MosaicIssuance describes a mosaic issued by a single complete aggregate
+type MosaicIssuance struct {
+	Owner *PublicAccount
+	// Nonce is generated randomly when it is zero
+	Nonce      uint32
+	Properties *MosaicProperties
+	// Supply is the initial supply in atomic units
+	Supply Amount
+	// Namespace is the full name linked to the mosaic, e.g. token.gold. Missing levels are registered
+	// and the root is registered for NamespaceDuration blocks or for DefaultNamespaceRegistrationPeriod when it is zero
+	Namespace         string
+	NamespaceDuration Duration
+	// Levy is paid in the issued mosaic when its MosaicId is nil
+	Levy     *MosaicLevy
+	Metadata map[ScopedMetadataKey]string
+}
+
+// MosaicIssuancePlan is an unsigned aggregate issuing a mosaic and the state after its confirmation
+type MosaicIssuancePlan struct {
+	Aggregate *AggregateTransaction
+	MosaicId  *MosaicId
+	Nonce     uint32
+	// State is the mosaic after the aggregate is confirmed
+	State *MosaicInfo
+	// NamespaceId is the namespace linked to the mosaic or nil
+	NamespaceId *NamespaceId
+	// Registered are namespaces registered by the aggregate
+	Registered []string
+	Levy       *MosaicLevy
+	Metadata   map[ScopedMetadataKey]string
+}
+
+// MosaicIssuer builds transactions issuing mosaics and changing their supply within limits of the network
+type MosaicIssuer struct {
+	Client *Client
+}
+
+// returns MosaicIssuer
+func NewMosaicIssuer(client *Client) *MosaicIssuer {
+	return &MosaicIssuer{Client: client}
+}
+
+// Limits returns mosaic limits from config of the network. Defaults are used for missing values
+func (i *MosaicIssuer) Limits(ctx context.Context) (*MosaicLimits, error) {
+	cfg, err := i.Client.Network.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime, err := i.Client.BlockGenerationTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := &MosaicLimits{
+		MaxDivisibility:   DefaultMaxMosaicDivisibility,
+		MaxDivisibleUnits: DefaultMaxMosaicDivisibleUnits,
+		MaxDuration:       Duration(DefaultMaxMosaicDuration / blockTime),
+	}
+
+	pl, ok := cfg.NetworkConfig.Sections["plugin:catapult.plugins.mosaic"]
+	if !ok {
+		return limits, nil
+	}
+
+	if v, ok := pl.Fields["maxMosaicDivisibility"]; ok {
+		d, err := strconv.ParseUint(configNumber(v.Value), 10, 8)
+		if err != nil {
+			return nil, err
+		}
+		limits.MaxDivisibility = uint8(d)
+	}
+
+	if v, ok := pl.Fields["maxMosaicDivisibleUnits"]; ok {
+		units, err := strconv.ParseInt(configNumber(v.Value), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		limits.MaxDivisibleUnits = Amount(units)
+	}
+
+	if v, ok := pl.Fields["maxMosaicDuration"]; ok {
+		d, err := parseConfigDuration(v.Value)
+		if err != nil {
+			return nil, err
+		}
+		limits.MaxDuration = Duration(d / blockTime)
+	}
+
+	return limits, nil
+}
+
+// Validate checks properties, supply and levy of the issuance against limits.
+// It returns the status errors the network would reject the issuance with
+func (i *MosaicIssuer) Validate(issuance *MosaicIssuance, limits *MosaicLimits) error {
+	if issuance.Owner == nil {
+		return ErrNilAccount
+	}
+
+	if issuance.Properties == nil {
+		return ErrNilMosaicProperties
+	}
+
+	if issuance.Properties.Divisibility > limits.MaxDivisibility {
+		return ErrMosaicInvalidDivisibility
+	}
+
+	if issuance.Properties.Duration() > limits.MaxDuration {
+		return ErrMosaicInvalidDuration
+	}
+
+	if issuance.Supply < 0 {
+		return ErrArgumentNotValid
+	}
+
+	if issuance.Supply > limits.MaxDivisibleUnits {
+		return ErrMosaicSupplyExceeded
+	}
+
+	if issuance.Levy != nil && issuance.Levy.Type != LevyNone && issuance.Levy.Recipient == nil {
+		return ErrNilAddress
+	}
+
+	return nil
+}
+
+// Issue validates the issuance and returns the aggregate of mosaic definition, supply, namespace registration,
+// mosaic alias, levy and metadata transactions in this order. The aggregate should be signed by the owner
+func (i *MosaicIssuer) Issue(ctx context.Context, issuance *MosaicIssuance) (*MosaicIssuancePlan, error) {
+	limits, err := i.Limits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.Validate(issuance, limits); err != nil {
+		return nil, err
+	}
+
+	nonce := issuance.Nonce
+	for nonce == 0 {
+		b := make([]byte, 4)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		nonce = binary.LittleEndian.Uint32(b)
+	}
+
+	deadline, err := i.Client.NewDeadline(time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := i.Client.NewMosaicDefinitionTransaction(deadline, nonce, issuance.Owner.PublicKey, issuance.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &MosaicIssuancePlan{
+		MosaicId: definition.MosaicId,
+		Nonce:    nonce,
+		State: &MosaicInfo{
+			MosaicId:   definition.MosaicId,
+			Supply:     issuance.Supply,
+			Owner:      issuance.Owner,
+			Revision:   1,
+			Properties: issuance.Properties,
+		},
+		Registered: make([]string, 0),
+		Metadata:   issuance.Metadata,
+	}
+
+	txs := []Transaction{definition}
+
+	if issuance.Supply > 0 {
+		supply, err := i.Client.NewMosaicSupplyChangeTransaction(deadline, plan.MosaicId, Increase, issuance.Supply)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, supply)
+	}
+
+	if issuance.Namespace != "" {
+		duration := issuance.NamespaceDuration
+		if duration == 0 {
+			blockTime, err := i.Client.BlockGenerationTime(ctx)
+			if err != nil {
+				return nil, err
+			}
+			duration = Duration(DefaultNamespaceRegistrationPeriod / blockTime)
+		}
+
+		registrations, err := i.registrations(ctx, issuance.Owner, issuance.Namespace, duration, deadline)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range registrations {
+			txs = append(txs, r)
+			plan.Registered = append(plan.Registered, r.NamspaceName)
+		}
+
+		plan.NamespaceId, err = NewNamespaceIdFromName(issuance.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		alias, err := i.Client.NewMosaicAliasTransaction(deadline, plan.MosaicId, plan.NamespaceId, AliasLink)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, alias)
+	}
+
+	if issuance.Levy != nil && issuance.Levy.Type != LevyNone {
+		levy := *issuance.Levy
+		if levy.MosaicId == nil {
+			levy.MosaicId = plan.MosaicId
+		}
+
+		levyTx, err := i.Client.NewMosaicModifyLevyTransaction(deadline, plan.MosaicId, &levy)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, levyTx)
+		plan.Levy = &levy
+	}
+
+	keys := make([]ScopedMetadataKey, 0, len(issuance.Metadata))
+	for key := range issuance.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		return keys[a] < keys[b]
+	})
+
+	for _, key := range keys {
+		metadata, err := i.Client.NewMosaicMetadataTransaction(deadline, plan.MosaicId, issuance.Owner, key, issuance.Metadata[key], "")
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, metadata)
+	}
+
+	for _, tx := range txs {
+		tx.GetAbstractTransaction().ToAggregate(issuance.Owner)
+	}
+
+	plan.Aggregate, err = i.Client.NewCompleteAggregateTransaction(deadline, txs)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// ChangeSupply returns a supply change of the mosaic after checking that the supply is mutable and
+// stays within zero and the maximum of divisible units. Decimal deltas can be converted with ParseDecimalAmount
+func (i *MosaicIssuer) ChangeSupply(ctx context.Context, mosaicId *MosaicId, supplyType MosaicSupplyType, delta Amount) (*MosaicSupplyChangeTransaction, error) {
+	if delta <= 0 {
+		return nil, ErrArgumentNotValid
+	}
+
+	info, err := i.Client.Mosaic.GetMosaicInfo(ctx, mosaicId)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Properties == nil {
+		return nil, ErrNilMosaicProperties
+	}
+
+	if !info.Properties.SupplyMutable {
+		return nil, ErrMosaicSupplyImmutable
+	}
+
+	switch supplyType {
+	case Increase:
+		limits, err := i.Limits(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if delta > limits.MaxDivisibleUnits-info.Supply {
+			return nil, ErrMosaicSupplyExceeded
+		}
+	case Decrease:
+		if delta > info.Supply {
+			return nil, ErrMosaicSupplyNegative
+		}
+	}
+
+	deadline, err := i.Client.NewDeadline(time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.Client.NewMosaicSupplyChangeTransaction(deadline, mosaicId, supplyType, delta)
+}
+
+// registrations returns transactions registering levels of the namespace which do not exist yet.
+// The root is registered for duration blocks
+func (i *MosaicIssuer) registrations(ctx context.Context, owner *PublicAccount, name string, duration Duration, deadline *Deadline) ([]*RegisterNamespaceTransaction, error) {
+	path, err := GenerateNamespacePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(name, ".")
+	txs := make([]*RegisterNamespaceTransaction, 0)
+	for level, namespaceId := range path {
+		info, err := i.Client.Namespace.GetNamespaceInfo(ctx, namespaceId)
+		switch {
+		case IsNotFound(err):
+			info = nil
+		case err != nil:
+			return nil, err
+		}
+
+		if info != nil {
+			if info.Owner != nil && !samePublicKey(info.Owner.PublicKey, owner.PublicKey) {
+				return nil, ErrNamespaceOwnerConflict
+			}
+
+			if level == len(path)-1 && info.Alias != nil && info.Alias.Type != NoneAliasType {
+				return nil, ErrNamespaceAliasAlreadyExists
+			}
+
+			continue
+		}
+
+		var tx *RegisterNamespaceTransaction
+		if level == 0 {
+			tx, err = i.Client.NewRegisterRootNamespaceTransaction(deadline, parts[0], duration)
+		} else {
+			tx, err = i.Client.NewRegisterSubNamespaceTransaction(deadline, parts[level], path[level-1])
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// configNumber removes digit separators of config values, e.g. 9'000'000
+func configNumber(v string) string {
+	return strings.ReplaceAll(strings.TrimSpace(v), "'", "")
+}
@@ -0,0 +1,150 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMosaicConfig = "[chain]\n\nblockGenerationTargetTime = 15s\n\n" +
+	"[plugin:catapult.plugins.mosaic]\n\nmaxMosaicDivisibility = 6\nmaxMosaicDivisibleUnits = 9'000'000'000'000'000\nmaxMosaicDuration = 3650d\n"
+
+type fakeNamespaceInfos struct {
+	NamespaceService
+	infos map[NamespaceId]*NamespaceInfo
+}
+
+func (s *fakeNamespaceInfos) GetNamespaceInfo(_ context.Context, nsId *NamespaceId) (*NamespaceInfo, error) {
+	info, ok := s.infos[*nsId]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return info, nil
+}
+
+type fakeMosaicInfo struct {
+	MosaicService
+	info *MosaicInfo
+}
+
+func (s *fakeMosaicInfo) GetMosaicInfo(context.Context, *MosaicId) (*MosaicInfo, error) {
+	return s.info, nil
+}
+
+func newMosaicIssuerT(t *testing.T) (*Client, *fakeNamespaceInfos) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+	config.GenerationHash = &Hash{}
+
+	namespaces := &fakeNamespaceInfos{infos: make(map[NamespaceId]*NamespaceInfo)}
+	client := NewClient(nil, config)
+	client.Network = &fakeNetworkConfigService{config: testMosaicConfig}
+	client.Namespace = namespaces
+	markSynced(client)
+
+	return client, namespaces
+}
+
+func TestMosaicIssuer_Issue(t *testing.T) {
+	client, namespaces := newMosaicIssuerT(t)
+	owner, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	other, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	token, err := NewNamespaceIdFromName("token")
+	require.NoError(t, err)
+	namespaces.infos[*token] = &NamespaceInfo{NamespaceId: token, Owner: owner.PublicAccount}
+
+	issuer := NewMosaicIssuer(client)
+
+	limits, err := issuer.Limits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &MosaicLimits{MaxDivisibility: 6, MaxDivisibleUnits: 9000000000000000, MaxDuration: 3650 * 5760}, limits)
+
+	issuance := &MosaicIssuance{
+		Owner:      owner.PublicAccount,
+		Nonce:      42,
+		Properties: NewMosaicProperties(true, true, 6, 0),
+		Supply:     1000000000,
+		Namespace:  "token.gold",
+		Levy:       &MosaicLevy{Type: LevyAbsoluteFee, Recipient: other.Address, Fee: 10},
+		Metadata:   map[ScopedMetadataKey]string{2: "Gold", 1: "GLD"},
+	}
+
+	plan, err := issuer.Issue(ctx, issuance)
+	require.NoError(t, err)
+
+	mosaicId, err := NewMosaicIdFromNonceAndOwner(42, owner.PublicAccount.PublicKey)
+	require.NoError(t, err)
+	assert.Equal(t, mosaicId, plan.MosaicId)
+	assert.Equal(t, mosaicId, plan.State.MosaicId)
+	assert.Equal(t, Amount(1000000000), plan.State.Supply)
+	assert.Equal(t, []string{"gold"}, plan.Registered)
+	assert.Equal(t, mosaicId, plan.Levy.MosaicId)
+
+	types := make([]EntityType, 0)
+	for _, tx := range plan.Aggregate.InnerTransactions {
+		types = append(types, tx.GetAbstractTransaction().Type)
+		assert.Equal(t, owner.PublicAccount, tx.GetAbstractTransaction().Signer)
+	}
+	assert.Equal(t, []EntityType{
+		MosaicDefinition, MosaicSupplyChange, RegisterNamespace, MosaicAlias, MosaicModifyLevy, MosaicMetadata, MosaicMetadata,
+	}, types)
+	assert.Equal(t, ScopedMetadataKey(1), plan.Aggregate.InnerTransactions[5].(*MosaicMetadataTransaction).ScopedMetadataKey)
+
+	_, err = owner.Sign(plan.Aggregate)
+	require.NoError(t, err)
+
+	issuance.Properties = NewMosaicProperties(true, true, 7, 0)
+	_, err = issuer.Issue(ctx, issuance)
+	assert.Equal(t, ErrMosaicInvalidDivisibility, err)
+
+	issuance.Properties = NewMosaicProperties(true, true, 6, 0)
+	issuance.Supply = 9000000000000001
+	_, err = issuer.Issue(ctx, issuance)
+	assert.Equal(t, ErrMosaicSupplyExceeded, err)
+
+	issuance.Supply = 1
+	namespaces.infos[*token].Owner = other.PublicAccount
+	_, err = issuer.Issue(ctx, issuance)
+	assert.Equal(t, ErrNamespaceOwnerConflict, err)
+}
+
+func TestMosaicIssuer_ChangeSupply(t *testing.T) {
+	client, _ := newMosaicIssuerT(t)
+	mosaicId, err := NewMosaicId(0x1234)
+	require.NoError(t, err)
+
+	mosaics := &fakeMosaicInfo{info: &MosaicInfo{
+		MosaicId:   mosaicId,
+		Supply:     DefaultMaxMosaicDivisibleUnits - 10,
+		Properties: NewMosaicProperties(true, true, 6, 0),
+	}}
+	client.Mosaic = mosaics
+
+	issuer := NewMosaicIssuer(client)
+
+	tx, err := issuer.ChangeSupply(ctx, mosaicId, Increase, 10)
+	require.NoError(t, err)
+	assert.Equal(t, Amount(10), tx.Delta)
+
+	_, err = issuer.ChangeSupply(ctx, mosaicId, Increase, 11)
+	assert.Equal(t, ErrMosaicSupplyExceeded, err)
+
+	mosaics.info.Supply = 10
+	_, err = issuer.ChangeSupply(ctx, mosaicId, Decrease, 11)
+	assert.Equal(t, ErrMosaicSupplyNegative, err)
+
+	mosaics.info.Properties = NewMosaicProperties(false, true, 6, 0)
+	_, err = issuer.ChangeSupply(ctx, mosaicId, Decrease, 1)
+	assert.Equal(t, ErrMosaicSupplyImmutable, err)
+}
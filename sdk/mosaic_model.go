@@ -311,6 +311,21 @@ func (levy *MosaicLevy) String() string {
 	)
 }
 
+// Amount returns the levy paid in levy.MosaicId for transferring amount of the levied mosaic
+func (levy *MosaicLevy) Amount(amount Amount) Amount {
+	switch levy.Type {
+	case LevyAbsoluteFee:
+		return levy.Fee
+	case LevyPercentileFee:
+		// split amount to avoid overflow of amount * fee
+		const denominator = MosaicLevyDecimalPlace * 100
+		q, r := uint64(amount)/denominator, uint64(amount)%denominator
+		return Amount(q*uint64(levy.Fee) + r*uint64(levy.Fee)/denominator)
+	default:
+		return 0
+	}
+}
+
 func (levy *MosaicLevy) SetBuffers(builder *flatbuffers.Builder, r []byte) flatbuffers.UOffsetT {
 
 	rV := transactions.TransactionBufferCreateByteVector(builder, r)
@@ -65,3 +65,15 @@ func TestNewMosaicIdFromNonceAndOwner(t *testing.T) {
 		assert.Equal(t, m.expectedMosaicId, mosaicId.toHexString())
 	}
 }
+
+func TestMosaicLevy_Amount(t *testing.T) {
+	absolute := &MosaicLevy{Type: LevyAbsoluteFee, Fee: 7}
+	assert.Equal(t, Amount(7), absolute.Amount(1000))
+
+	percentile := &MosaicLevy{Type: LevyPercentileFee, Fee: CreateMosaicLevyFeePercentile(1.5)}
+	assert.Equal(t, Amount(15), percentile.Amount(1000))
+	assert.Equal(t, Amount(15000000000000000), percentile.Amount(1000000000000000000))
+
+	none := &MosaicLevy{Type: LevyNone, Fee: 7}
+	assert.Equal(t, Amount(0), none.Amount(1000))
+}
@@ -107,7 +107,7 @@ var (
 		Height:   uint64DTO{1, 0}.toStruct(),
 		Owner: &PublicAccount{
 			Address: &Address{
-				Type:    mosaicClient.client.config.NetworkType,
+				Type:    mosaicClient.(*mosaicService).client.config.NetworkType,
 				Address: "VBFBW6TUGLEWQIBCMTBMXXQORZKUP3WTVX36ZFE7",
 			},
 
@@ -136,7 +136,7 @@ var (
 	mosaicLevy = &MosaicLevy{
 		Type: 2,
 		Recipient: &Address{
-			Type:    mosaicClient.client.config.NetworkType,
+			Type:    mosaicClient.(*mosaicService).client.config.NetworkType,
 			Address: "VCAWORKQTHTWOZ2YWOF5QKBLF7WAAQLMD5FKNEDK",
 		},
 		Fee:      100,
@@ -0,0 +1,437 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"sort"
+	"strings"
+)
+
+// MultisigGraph is a queryable view of MultisigAccountGraphInfo.
+// Accounts are indexed by public key, so levels of the graph don't matter for the queries.
+type MultisigGraph struct {
+	accounts map[string]*MultisigAccountInfo
+}
+
+// returns MultisigGraph built from every level of passed MultisigAccountGraphInfo
+func NewMultisigGraph(info *MultisigAccountGraphInfo) *MultisigGraph {
+	g := &MultisigGraph{
+		accounts: make(map[string]*MultisigAccountInfo),
+	}
+
+	if info == nil {
+		return g
+	}
+
+	for _, level := range info.MultisigAccounts {
+		for _, acc := range level {
+			if acc == nil {
+				continue
+			}
+
+			g.accounts[multisigKey(acc.Account.PublicKey)] = acc
+		}
+	}
+
+	return g
+}
+
+// Account returns MultisigAccountInfo of passed public key if it is a part of the graph
+func (g *MultisigGraph) Account(publicKey string) (*MultisigAccountInfo, bool) {
+	acc, ok := g.accounts[multisigKey(publicKey)]
+	return acc, ok
+}
+
+// IsMultisig returns true if passed public key has cosignatories in the graph
+func (g *MultisigGraph) IsMultisig(publicKey string) bool {
+	acc, ok := g.Account(publicKey)
+	return ok && len(acc.Cosignatories) > 0
+}
+
+// maxSignerCombinations bounds the number of signer sets checked by MinimalSigners
+const maxSignerCombinations = 1 << 16
+
+// EligibleSigners returns every non-multisig key which cosignature can count towards minApproval of passed account.
+// A regular account is the only eligible signer of itself.
+func (g *MultisigGraph) EligibleSigners(publicKey string) []*PublicAccount {
+	signers, _ := g.eligibleSigners(publicKey)
+
+	return sortedPublicAccounts(signers)
+}
+
+// eligibleSigners collects non-multisig keys reachable from passed key. Every key is collected even when
+// a multisig account is its own cosignatory, ErrMultisigLoop is returned then
+func (g *MultisigGraph) eligibleSigners(publicKey string) (map[string]*PublicAccount, error) {
+	signers := make(map[string]*PublicAccount)
+	visited := make(map[string]bool)
+	path := make(map[string]bool)
+	loop := false
+
+	var walk func(key string, account *PublicAccount)
+	walk = func(key string, account *PublicAccount) {
+		if path[key] {
+			loop = true
+			return
+		}
+		if visited[key] {
+			return
+		}
+		visited[key] = true
+
+		acc, ok := g.accounts[key]
+		if !ok || len(acc.Cosignatories) == 0 {
+			if account == nil && ok {
+				account = &acc.Account
+			}
+			if account != nil {
+				signers[key] = account
+			}
+			return
+		}
+
+		path[key] = true
+		for _, c := range acc.Cosignatories {
+			walk(multisigKey(c.PublicKey), c)
+		}
+		delete(path, key)
+	}
+	walk(multisigKey(publicKey), nil)
+
+	if loop {
+		return signers, ErrMultisigLoop
+	}
+
+	return signers, nil
+}
+
+// MinimalSigners returns the smallest set of non-multisig keys which satisfies minApproval of passed account
+// and minApproval of every nested multisig account on the way.
+// Combinations of eligible signers are checked from the smallest one, so a key cosigning several nested
+// multisig accounts is counted once. The search is bounded by maxSignerCombinations checks. Wider graphs get
+// an irreducible set instead: no key of it can be dropped, but a smaller set may exist.
+func (g *MultisigGraph) MinimalSigners(publicKey string) ([]*PublicAccount, error) {
+	return g.minimalSigners(publicKey, false)
+}
+
+// MinimalRemovalSigners is the same as MinimalSigners, but uses minRemoval of passed account.
+// It is the set required to announce ModifyMultisigAccountTransaction which removes cosignatories.
+func (g *MultisigGraph) MinimalRemovalSigners(publicKey string) ([]*PublicAccount, error) {
+	return g.minimalSigners(publicKey, true)
+}
+
+func (g *MultisigGraph) minimalSigners(publicKey string, removal bool) ([]*PublicAccount, error) {
+	set, err := g.minimalSet(publicKey, removal, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedPublicAccounts(set), nil
+}
+
+// minimalSet returns the first smallest combination of eligible signers which satisfies passed account.
+// signable limits keys which can sign, nil means every key can sign
+func (g *MultisigGraph) minimalSet(publicKey string, removal bool, signable map[string]*Account) (map[string]*PublicAccount, error) {
+	key := multisigKey(publicKey)
+	if _, ok := g.accounts[key]; !ok {
+		return nil, ErrMultisigUnknownAccount
+	}
+
+	eligible, err := g.eligibleSigners(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if signable != nil {
+		for k := range eligible {
+			if _, ok := signable[k]; !ok {
+				delete(eligible, k)
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(eligible))
+	for k := range eligible {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// the irreducible set bounds the size of searched combinations
+	best := g.irreducibleSet(key, removal, keys)
+	if best == nil {
+		return nil, ErrMultisigUnsatisfiable
+	}
+
+	budget := maxSignerCombinations
+	chosen := make(map[string]bool, len(keys))
+	for size := 1; size < len(best) && budget > 0; size++ {
+		if combination := g.satisfyingCombination(key, removal, keys, size, chosen, &budget); combination != nil {
+			best = combination
+			break
+		}
+	}
+
+	set := make(map[string]*PublicAccount, len(best))
+	for _, k := range best {
+		set[k] = eligible[k]
+	}
+
+	return set, nil
+}
+
+// irreducibleSet drops keys one by one while the rest still satisfies passed account.
+// It returns nil when all keys together don't satisfy the account
+func (g *MultisigGraph) irreducibleSet(key string, removal bool, keys []string) []string {
+	chosen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		chosen[k] = true
+	}
+
+	if !g.satisfied(key, removal, chosen) {
+		return nil
+	}
+
+	set := make([]string, 0, len(keys))
+	for _, k := range keys {
+		delete(chosen, k)
+		if !g.satisfied(key, removal, chosen) {
+			chosen[k] = true
+			set = append(set, k)
+		}
+	}
+
+	return set
+}
+
+// satisfyingCombination returns the first combination of size keys which satisfies passed account or nil.
+// Every checked combination is taken from budget, the search stops when it is spent
+func (g *MultisigGraph) satisfyingCombination(key string, removal bool, keys []string, size int, chosen map[string]bool, budget *int) []string {
+	current := make([]string, 0, size)
+
+	var choose func(start int) bool
+	choose = func(start int) bool {
+		if len(current) == size {
+			*budget--
+			return g.satisfied(key, removal, chosen)
+		}
+
+		for i := start; i <= len(keys)-(size-len(current)) && *budget > 0; i++ {
+			current = append(current, keys[i])
+			chosen[keys[i]] = true
+			found := choose(i + 1)
+			if found {
+				return true
+			}
+			delete(chosen, keys[i])
+			current = current[:len(current)-1]
+		}
+
+		return false
+	}
+
+	if !choose(0) {
+		return nil
+	}
+
+	for _, k := range current {
+		delete(chosen, k)
+	}
+
+	return current
+}
+
+// satisfied returns true when cosignatures of chosen keys satisfy passed account
+func (g *MultisigGraph) satisfied(key string, removal bool, chosen map[string]bool) bool {
+	acc, ok := g.accounts[key]
+	if !ok || len(acc.Cosignatories) == 0 {
+		return chosen[key]
+	}
+
+	cosigned := 0
+	for _, c := range acc.Cosignatories {
+		if g.satisfied(multisigKey(c.PublicKey), false, chosen) {
+			cosigned++
+		}
+	}
+
+	return cosigned >= requiredCosignatures(acc, removal)
+}
+
+// SelectCosigners picks accounts from available which, together with initiator, satisfy passed multisig account.
+// The result can be passed as cosignatories to Account.SignWithCosignatures for NewCompleteAggregateTransaction.
+func (g *MultisigGraph) SelectCosigners(publicKey string, initiator *Account, available []*Account) ([]*Account, error) {
+	if initiator == nil {
+		return nil, ErrNilAccount
+	}
+
+	accounts := make(map[string]*Account, len(available)+1)
+	for _, a := range available {
+		if a != nil {
+			accounts[multisigKey(a.PublicAccount.PublicKey)] = a
+		}
+	}
+	initiatorKey := multisigKey(initiator.PublicAccount.PublicKey)
+	accounts[initiatorKey] = initiator
+
+	set, err := g.minimalSet(publicKey, false, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	cosigners := make([]*Account, 0, len(set))
+	for _, pa := range sortedPublicAccounts(set) {
+		key := multisigKey(pa.PublicKey)
+		if key == initiatorKey {
+			continue
+		}
+		cosigners = append(cosigners, accounts[key])
+	}
+
+	return cosigners, nil
+}
+
+// ValidateModification checks that passed ModifyMultisigAccountTransaction applied to passed account
+// keeps minApproval and minRemoval within the cosignatories count and doesn't lock the account out.
+func (g *MultisigGraph) ValidateModification(publicKey string, tx *ModifyMultisigAccountTransaction) error {
+	if tx == nil {
+		return ErrNilMultisigModification
+	}
+
+	key := multisigKey(publicKey)
+	acc, ok := g.accounts[key]
+	if !ok {
+		pa, err := NewAccountFromPublicKey(publicKey, tx.NetworkType)
+		if err != nil {
+			return err
+		}
+		acc = &MultisigAccountInfo{Account: *pa}
+	}
+
+	cosignatories := make(map[string]*PublicAccount, len(acc.Cosignatories))
+	for _, c := range acc.Cosignatories {
+		cosignatories[multisigKey(c.PublicKey)] = c
+	}
+
+	for _, m := range tx.Modifications {
+		if m == nil || m.PublicAccount == nil {
+			return ErrNilMultisigModification
+		}
+
+		mKey := multisigKey(m.PublicKey)
+		switch m.Type {
+		case Add:
+			if _, ok := cosignatories[mKey]; ok {
+				return ErrMultisigAlreadyCosignatory
+			}
+			if mKey == key || g.isCosignatoryOf(key, mKey) {
+				return ErrMultisigLoop
+			}
+			cosignatories[mKey] = m.PublicAccount
+		case Remove:
+			if _, ok := cosignatories[mKey]; !ok {
+				return ErrMultisigNotCosignatory
+			}
+			delete(cosignatories, mKey)
+		}
+	}
+
+	minApproval := int(acc.MinApproval) + int(tx.MinApprovalDelta)
+	minRemoval := int(acc.MinRemoval) + int(tx.MinRemovalDelta)
+
+	// account stops being multisig and is controlled by its own key again
+	if len(cosignatories) == 0 {
+		if minApproval != 0 || minRemoval != 0 {
+			return ErrMultisigMinSettingOutOfRange
+		}
+		return nil
+	}
+
+	if minApproval < 1 || minApproval > len(cosignatories) || minRemoval < 1 || minRemoval > len(cosignatories) {
+		return ErrMultisigMinSettingOutOfRange
+	}
+
+	modified := &MultisigAccountInfo{
+		Account:          acc.Account,
+		MinApproval:      int32(minApproval),
+		MinRemoval:       int32(minRemoval),
+		Cosignatories:    sortedPublicAccounts(cosignatories),
+		MultisigAccounts: acc.MultisigAccounts,
+	}
+
+	next := &MultisigGraph{accounts: make(map[string]*MultisigAccountInfo, len(g.accounts))}
+	for k, v := range g.accounts {
+		next.accounts[k] = v
+	}
+	next.accounts[key] = modified
+
+	if _, err := next.MinimalSigners(publicKey); err != nil {
+		return err
+	}
+
+	if _, err := next.MinimalRemovalSigners(publicKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isCosignatoryOf returns true if descendant key is reachable from ancestor key through cosignatories
+func (g *MultisigGraph) isCosignatoryOf(descendant, ancestor string) bool {
+	visited := make(map[string]bool)
+	var walk func(key string) bool
+	walk = func(key string) bool {
+		if visited[key] {
+			return false
+		}
+		visited[key] = true
+
+		acc, ok := g.accounts[key]
+		if !ok {
+			return false
+		}
+
+		for _, c := range acc.Cosignatories {
+			cKey := multisigKey(c.PublicKey)
+			if cKey == descendant || walk(cKey) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return walk(ancestor)
+}
+
+func requiredCosignatures(acc *MultisigAccountInfo, removal bool) int {
+	required := int(acc.MinApproval)
+	if removal {
+		required = int(acc.MinRemoval)
+	}
+
+	if required < 1 {
+		required = 1
+	}
+
+	return required
+}
+
+func sortedPublicAccounts(accounts map[string]*PublicAccount) []*PublicAccount {
+	keys := make([]string, 0, len(accounts))
+	for key := range accounts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]*PublicAccount, len(keys))
+	for i, key := range keys {
+		result[i] = accounts[key]
+	}
+
+	return result
+}
+
+func multisigKey(publicKey string) string {
+	return strings.ToUpper(publicKey)
+}
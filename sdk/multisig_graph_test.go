@@ -0,0 +1,190 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type multisigGraphFixture struct {
+	multisig, nested     *Account
+	a, b, c, d, outsider *Account
+	graph                *MultisigGraph
+}
+
+func newMultisigGraphFixture(t *testing.T) *multisigGraphFixture {
+	f := &multisigGraphFixture{}
+	for _, acc := range []**Account{&f.multisig, &f.nested, &f.a, &f.b, &f.c, &f.d, &f.outsider} {
+		var err error
+		*acc, err = NewAccount(MijinTest, nil)
+		require.NoError(t, err)
+	}
+
+	f.graph = NewMultisigGraph(&MultisigAccountGraphInfo{
+		MultisigAccounts: map[int32][]*MultisigAccountInfo{
+			0: {{
+				Account:       *f.multisig.PublicAccount,
+				MinApproval:   2,
+				MinRemoval:    1,
+				Cosignatories: []*PublicAccount{f.a.PublicAccount, f.b.PublicAccount, f.nested.PublicAccount},
+			}},
+			1: {
+				{
+					Account:          *f.nested.PublicAccount,
+					MinApproval:      2,
+					MinRemoval:       1,
+					Cosignatories:    []*PublicAccount{f.c.PublicAccount, f.d.PublicAccount},
+					MultisigAccounts: []*PublicAccount{f.multisig.PublicAccount},
+				},
+				{Account: *f.a.PublicAccount, MultisigAccounts: []*PublicAccount{f.multisig.PublicAccount}},
+				{Account: *f.b.PublicAccount, MultisigAccounts: []*PublicAccount{f.multisig.PublicAccount}},
+			},
+		},
+	})
+
+	return f
+}
+
+func publicKeys(accounts []*PublicAccount) []string {
+	keys := make([]string, len(accounts))
+	for i, a := range accounts {
+		keys[i] = a.PublicKey
+	}
+	return keys
+}
+
+func TestMultisigGraph_EligibleSigners(t *testing.T) {
+	f := newMultisigGraphFixture(t)
+
+	signers := f.graph.EligibleSigners(f.multisig.PublicAccount.PublicKey)
+
+	assert.ElementsMatch(t,
+		[]string{f.a.PublicAccount.PublicKey, f.b.PublicAccount.PublicKey, f.c.PublicAccount.PublicKey, f.d.PublicAccount.PublicKey},
+		publicKeys(signers),
+	)
+	assert.True(t, f.graph.IsMultisig(f.nested.PublicAccount.PublicKey))
+	assert.False(t, f.graph.IsMultisig(f.a.PublicAccount.PublicKey))
+}
+
+func TestMultisigGraph_MinimalSigners(t *testing.T) {
+	f := newMultisigGraphFixture(t)
+
+	signers, err := f.graph.MinimalSigners(f.multisig.PublicAccount.PublicKey)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{f.a.PublicAccount.PublicKey, f.b.PublicAccount.PublicKey}, publicKeys(signers))
+
+	signers, err = f.graph.MinimalRemovalSigners(f.multisig.PublicAccount.PublicKey)
+	require.NoError(t, err)
+	assert.Len(t, signers, 1)
+
+	// y cosigns both nested accounts, so it satisfies 2 of 2 alone
+	accounts := make([]*Account, 6)
+	for i := range accounts {
+		accounts[i], err = NewAccount(MijinTest, nil)
+		require.NoError(t, err)
+	}
+	m, n1, n2, x, y, z := accounts[0], accounts[1], accounts[2], accounts[3], accounts[4], accounts[5]
+
+	graph := NewMultisigGraph(&MultisigAccountGraphInfo{
+		MultisigAccounts: map[int32][]*MultisigAccountInfo{
+			0: {{
+				Account:       *m.PublicAccount,
+				MinApproval:   2,
+				MinRemoval:    2,
+				Cosignatories: []*PublicAccount{n1.PublicAccount, n2.PublicAccount},
+			}},
+			1: {
+				{Account: *n1.PublicAccount, MinApproval: 1, MinRemoval: 1, Cosignatories: []*PublicAccount{x.PublicAccount, y.PublicAccount}},
+				{Account: *n2.PublicAccount, MinApproval: 1, MinRemoval: 1, Cosignatories: []*PublicAccount{y.PublicAccount, z.PublicAccount}},
+			},
+		},
+	})
+
+	signers, err = graph.MinimalSigners(m.PublicAccount.PublicKey)
+	require.NoError(t, err)
+	assert.Equal(t, []string{y.PublicAccount.PublicKey}, publicKeys(signers))
+}
+
+func TestMultisigGraph_MinimalSignersWide(t *testing.T) {
+	newPublicAccount := func() *PublicAccount {
+		acc, err := NewAccount(MijinTest, nil)
+		require.NoError(t, err)
+		return acc.PublicAccount
+	}
+
+	// 10 of 10 nested accounts with 10 own cosignatories each exceed the search budget
+	root := newPublicAccount()
+	nested := make([]*PublicAccount, 10)
+	level := make([]*MultisigAccountInfo, 0, len(nested))
+	for i := range nested {
+		nested[i] = newPublicAccount()
+		cosignatories := make([]*PublicAccount, 10)
+		for j := range cosignatories {
+			cosignatories[j] = newPublicAccount()
+		}
+		level = append(level, &MultisigAccountInfo{Account: *nested[i], MinApproval: 1, MinRemoval: 1, Cosignatories: cosignatories})
+	}
+
+	graph := NewMultisigGraph(&MultisigAccountGraphInfo{
+		MultisigAccounts: map[int32][]*MultisigAccountInfo{
+			0: {{Account: *root, MinApproval: 10, MinRemoval: 10, Cosignatories: nested}},
+			1: level,
+		},
+	})
+
+	signers, err := graph.MinimalSigners(root.PublicKey)
+	require.NoError(t, err)
+	assert.Len(t, signers, 10)
+}
+
+func TestMultisigGraph_SelectCosigners(t *testing.T) {
+	f := newMultisigGraphFixture(t)
+
+	t.Run("nested multisig replaces missing key", func(t *testing.T) {
+		cosigners, err := f.graph.SelectCosigners(f.multisig.PublicAccount.PublicKey, f.a, []*Account{f.c, f.d, f.outsider})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*Account{f.c, f.d}, cosigners)
+	})
+
+	t.Run("not enough keys", func(t *testing.T) {
+		_, err := f.graph.SelectCosigners(f.multisig.PublicAccount.PublicKey, f.a, []*Account{f.c})
+		assert.Equal(t, ErrMultisigUnsatisfiable, err)
+	})
+}
+
+func TestMultisigGraph_ValidateModification(t *testing.T) {
+	f := newMultisigGraphFixture(t)
+
+	modify := func(approval, removal int8, mods ...*MultisigCosignatoryModification) *ModifyMultisigAccountTransaction {
+		tx, err := NewModifyMultisigAccountTransaction(fakeDeadline, approval, removal, mods, MijinTest)
+		require.NoError(t, err)
+		return tx
+	}
+
+	assert.NoError(t, f.graph.ValidateModification(f.multisig.PublicAccount.PublicKey,
+		modify(0, 0, &MultisigCosignatoryModification{Add, f.outsider.PublicAccount})))
+
+	assert.Equal(t, ErrMultisigMinSettingOutOfRange, f.graph.ValidateModification(f.multisig.PublicAccount.PublicKey,
+		modify(2, 0)))
+
+	assert.Equal(t, ErrMultisigMinSettingOutOfRange, f.graph.ValidateModification(f.multisig.PublicAccount.PublicKey,
+		modify(0, -1)))
+
+	assert.Equal(t, ErrMultisigAlreadyCosignatory, f.graph.ValidateModification(f.multisig.PublicAccount.PublicKey,
+		modify(0, 0, &MultisigCosignatoryModification{Add, f.a.PublicAccount})))
+
+	assert.Equal(t, ErrMultisigNotCosignatory, f.graph.ValidateModification(f.multisig.PublicAccount.PublicKey,
+		modify(0, 0, &MultisigCosignatoryModification{Remove, f.outsider.PublicAccount})))
+
+	assert.Equal(t, ErrMultisigLoop, f.graph.ValidateModification(f.nested.PublicAccount.PublicKey,
+		modify(0, 0, &MultisigCosignatoryModification{Add, f.multisig.PublicAccount})))
+
+	// nested multisig needs both of its cosignatures, so removing one of them locks it out
+	assert.Equal(t, ErrMultisigMinSettingOutOfRange, f.graph.ValidateModification(f.nested.PublicAccount.PublicKey,
+		modify(0, 0, &MultisigCosignatoryModification{Remove, f.c.PublicAccount})))
+}
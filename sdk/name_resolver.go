@@ -0,0 +1,373 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultNameResolverTTL = time.Minute * 5
+	// amountSeparator separates an asset from the amount, e.g. prx.xpx:12.5
+	amountSeparator = ":"
+	mosaicIdHexSize = 16
+	// rawAddressSize is the length of a base32 encoded address without dashes
+	rawAddressSize = 40
+	// minCacheSweepSize is the cache size at which expired entries are swept for the first time
+	minCacheSweepSize = 64
+)
+
+// ttlCache keeps values until they expire or are invalidated. Expired entries are swept when the cache
+// doubles since the last sweep, so it holds at most twice as many entries as are alive. The zero value is ready to use
+type ttlCache[K comparable, V any] struct {
+	m       sync.Mutex
+	entries map[K]*ttlEntry[V]
+	sweepAt int
+}
+
+type ttlEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func newTtlCache[K comparable, V any]() *ttlCache[K, V] {
+	return &ttlCache[K, V]{entries: make(map[K]*ttlEntry[V])}
+}
+
+func (c *ttlCache[K, V]) get(key K) (V, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		delete(c.entries, key)
+		var v V
+		return v, false
+	}
+
+	return e.value, true
+}
+
+func (c *ttlCache[K, V]) set(key K, value V, ttl time.Duration) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[K]*ttlEntry[V])
+	}
+
+	now := time.Now()
+	if len(c.entries) >= c.sweepAt {
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+
+		c.sweepAt = 2 * len(c.entries)
+		if c.sweepAt < minCacheSweepSize {
+			c.sweepAt = minCacheSweepSize
+		}
+	}
+
+	c.entries[key] = &ttlEntry[V]{value: value, expires: now.Add(ttl)}
+}
+
+func (c *ttlCache[K, V]) delete(key K) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	delete(c.entries, key)
+}
+
+// NameResolver resolves human-readable recipients, assets and amounts, e.g. alice.wallet or prx.xpx:12.5.
+// Aliases and divisibilities are cached for TTL. Alias transactions passed to InvalidateTransaction
+// drop cached aliases of their namespaces
+type NameResolver struct {
+	Client *Client
+	TTL    time.Duration
+
+	addresses      ttlCache[NamespaceId, *Address]
+	mosaics        ttlCache[NamespaceId, *MosaicId]
+	divisibilities ttlCache[MosaicId, uint8]
+}
+
+// returns NameResolver with DefaultNameResolverTTL
+func NewNameResolver(client *Client) *NameResolver {
+	return &NameResolver{
+		Client: client,
+		TTL:    DefaultNameResolverTTL,
+	}
+}
+
+// ResolveAddress returns the address of a raw or pretty address, a public key or a namespace linked to an address
+func (r *NameResolver) ResolveAddress(ctx context.Context, recipient string) (*Address, error) {
+	recipient = strings.TrimSpace(recipient)
+
+	if raw := strings.ReplaceAll(recipient, "-", ""); len(raw) == rawAddressSize && raw == strings.ToUpper(raw) {
+		if address, err := NewAddressFromRaw(raw); err == nil {
+			return address, nil
+		}
+	}
+
+	if len(recipient) == len(EmptyPublicKey) && isHex(recipient) {
+		return NewAddressFromPublicKey(recipient, r.Client.NetworkType())
+	}
+
+	namespaceId, err := NewNamespaceIdFromName(recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.linkedAddress(ctx, namespaceId)
+}
+
+// AddressOf returns the address linked to the namespace of an alias address, other addresses are returned as they are
+func (r *NameResolver) AddressOf(ctx context.Context, address *Address) (*Address, error) {
+	if address == nil {
+		return nil, ErrNilAddress
+	}
+
+	namespaceId, err := NewNamespaceIdFromAddress(address)
+	if err != nil || namespaceId == nil {
+		return address, err
+	}
+
+	return r.linkedAddress(ctx, namespaceId)
+}
+
+func (r *NameResolver) linkedAddress(ctx context.Context, namespaceId *NamespaceId) (*Address, error) {
+	if address, ok := r.addresses.get(*namespaceId); ok {
+		return address, nil
+	}
+
+	address, err := r.Client.Namespace.GetLinkedAddress(ctx, namespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if address == nil {
+		return nil, ErrNamespaceNotLinked
+	}
+
+	r.addresses.set(*namespaceId, address, r.TTL)
+
+	return address, nil
+}
+
+// ResolveMosaicId returns the mosaic id of a hex mosaic id or a namespace linked to a mosaic.
+// Strings of 16 hex digits are read as mosaic ids
+func (r *NameResolver) ResolveMosaicId(ctx context.Context, asset string) (*MosaicId, error) {
+	asset = strings.TrimSpace(asset)
+
+	if len(asset) == mosaicIdHexSize && isHex(asset) {
+		id, err := strconv.ParseUint(asset, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewMosaicId(id)
+	}
+
+	namespaceId, err := NewNamespaceIdFromName(asset)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.linkedMosaicId(ctx, namespaceId)
+}
+
+// MosaicIdOf returns the mosaic id of an asset id, namespace aliases are resolved
+func (r *NameResolver) MosaicIdOf(ctx context.Context, assetId AssetId) (*MosaicId, error) {
+	switch assetId := assetId.(type) {
+	case *MosaicId:
+		if assetId != nil {
+			return assetId, nil
+		}
+	case *NamespaceId:
+		if assetId != nil {
+			return r.linkedMosaicId(ctx, assetId)
+		}
+	}
+
+	return nil, ErrNilAssetId
+}
+
+func (r *NameResolver) linkedMosaicId(ctx context.Context, namespaceId *NamespaceId) (*MosaicId, error) {
+	if mosaicId, ok := r.mosaics.get(*namespaceId); ok {
+		return mosaicId, nil
+	}
+
+	mosaicId, err := r.Client.Namespace.GetLinkedMosaicId(ctx, namespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if mosaicId == nil {
+		return nil, ErrNamespaceNotLinked
+	}
+
+	r.mosaics.set(*namespaceId, mosaicId, r.TTL)
+
+	return mosaicId, nil
+}
+
+// ResolveMosaic returns a mosaic from an asset and a decimal amount separated by a colon, e.g. prx.xpx:12.5.
+// The amount is converted to atomic units with divisibility of the mosaic
+func (r *NameResolver) ResolveMosaic(ctx context.Context, assetAmount string) (*Mosaic, error) {
+	i := strings.LastIndex(assetAmount, amountSeparator)
+	if i < 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	mosaicId, err := r.ResolveMosaicId(ctx, assetAmount[:i])
+	if err != nil {
+		return nil, err
+	}
+
+	divisibility, err := r.divisibility(ctx, mosaicId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := ParseDecimalAmount(assetAmount[i+1:], divisibility)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMosaic(mosaicId, amount)
+}
+
+// ResolveTransfer resolves the recipient and mosaics of a transfer, e.g. alice.wallet and prx.xpx:12.5
+func (r *NameResolver) ResolveTransfer(ctx context.Context, recipient string, assetAmounts ...string) (*Address, []*Mosaic, error) {
+	address, err := r.ResolveAddress(ctx, recipient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mosaics := make([]*Mosaic, 0, len(assetAmounts))
+	for _, assetAmount := range assetAmounts {
+		mosaic, err := r.ResolveMosaic(ctx, assetAmount)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		mosaics = append(mosaics, mosaic)
+	}
+
+	return address, mosaics, nil
+}
+
+// InvalidateTransaction drops cached aliases of namespaces changed by alias transactions, including inner
+// transactions of aggregates
+func (r *NameResolver) InvalidateTransaction(tx Transaction) {
+	switch tx := tx.(type) {
+	case *AddressAliasTransaction:
+		r.Invalidate(tx.NamespaceId)
+	case *MosaicAliasTransaction:
+		r.Invalidate(tx.NamespaceId)
+	case *AggregateTransaction:
+		for _, inner := range tx.InnerTransactions {
+			r.InvalidateTransaction(inner)
+		}
+	}
+}
+
+// Invalidate drops cached aliases of the namespace
+func (r *NameResolver) Invalidate(namespaceId *NamespaceId) {
+	if namespaceId == nil {
+		return
+	}
+
+	r.addresses.delete(*namespaceId)
+	r.mosaics.delete(*namespaceId)
+}
+
+// Watch invalidates cached aliases with transactions read from txs until it is closed or ctx is done,
+// e.g. with confirmed transactions of the websocket
+func (r *NameResolver) Watch(ctx context.Context, txs <-chan Transaction) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case tx, ok := <-txs:
+			if !ok {
+				return
+			}
+
+			r.InvalidateTransaction(tx)
+		}
+	}
+}
+
+func (r *NameResolver) divisibility(ctx context.Context, mosaicId *MosaicId) (uint8, error) {
+	if divisibility, ok := r.divisibilities.get(*mosaicId); ok {
+		return divisibility, nil
+	}
+
+	info, err := r.Client.Mosaic.GetMosaicInfo(ctx, mosaicId)
+	if err != nil {
+		return 0, err
+	}
+
+	if info.Properties == nil {
+		return 0, ErrNilMosaicProperties
+	}
+
+	r.divisibilities.set(*mosaicId, info.Properties.Divisibility, r.TTL)
+
+	return info.Properties.Divisibility, nil
+}
+
+// ParseDecimalAmount converts a decimal amount, e.g. 12.5, to atomic units of a mosaic with the divisibility
+func ParseDecimalAmount(amount string, divisibility uint8) (Amount, error) {
+	amount = strings.TrimSpace(amount)
+
+	whole, fraction := amount, ""
+	if i := strings.Index(amount, "."); i >= 0 {
+		whole, fraction = amount[:i], amount[i+1:]
+	}
+
+	if whole == "" && fraction == "" || len(fraction) > int(divisibility) ||
+		!isDigits(whole) || !isDigits(fraction) {
+		return 0, ErrInvalidAmount
+	}
+
+	digits := strings.TrimLeft(whole+fraction+strings.Repeat("0", int(divisibility)-len(fraction)), "0")
+	if digits == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidAmount
+	}
+
+	return Amount(v), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isHex(s string) bool {
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+
+	return true
+}
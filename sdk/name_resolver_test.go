@@ -0,0 +1,186 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAliases struct {
+	NamespaceService
+	addresses map[NamespaceId]*Address
+	mosaics   map[NamespaceId]*MosaicId
+	calls     int
+}
+
+func (s *fakeAliases) GetLinkedAddress(_ context.Context, namespaceId *NamespaceId) (*Address, error) {
+	s.calls++
+	return s.addresses[*namespaceId], nil
+}
+
+func (s *fakeAliases) GetLinkedMosaicId(_ context.Context, namespaceId *NamespaceId) (*MosaicId, error) {
+	s.calls++
+	return s.mosaics[*namespaceId], nil
+}
+
+type fakeMosaicInfos struct {
+	MosaicService
+	divisibility uint8
+}
+
+func (s *fakeMosaicInfos) GetMosaicInfo(_ context.Context, mosaicId *MosaicId) (*MosaicInfo, error) {
+	return &MosaicInfo{MosaicId: mosaicId, Properties: &MosaicProperties{
+		MosaicPropertiesHeader: MosaicPropertiesHeader{Divisibility: s.divisibility},
+	}}, nil
+}
+
+func TestNameResolver_ResolveTransfer(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	alice, err := NewAddressFromRaw("VAWOEOWTABXR7O3ZAK2XNA5GIBNE6PZIXDAFDWBU")
+	require.NoError(t, err)
+	xpx, err := NewMosaicId(0x0DC67FBE1CAD29E3)
+	require.NoError(t, err)
+	wallet, err := NewNamespaceIdFromName("alice.wallet")
+	require.NoError(t, err)
+	prx, err := NewNamespaceIdFromName("prx.xpx")
+	require.NoError(t, err)
+
+	aliases := &fakeAliases{
+		addresses: map[NamespaceId]*Address{*wallet: alice},
+		mosaics:   map[NamespaceId]*MosaicId{*prx: xpx},
+	}
+	client := NewClient(nil, config)
+	client.Namespace = aliases
+	client.Mosaic = &fakeMosaicInfos{divisibility: 6}
+
+	resolver := NewNameResolver(client)
+
+	recipient, mosaics, err := resolver.ResolveTransfer(ctx, "alice.wallet", "prx.xpx:12.5", "0DC67FBE1CAD29E3:1")
+	require.NoError(t, err)
+	assert.Equal(t, alice, recipient)
+	require.Len(t, mosaics, 2)
+	assert.Equal(t, xpx, mosaics[0].AssetId)
+	assert.Equal(t, Amount(12500000), mosaics[0].Amount)
+	assert.Equal(t, xpx, mosaics[1].AssetId)
+	assert.Equal(t, Amount(1000000), mosaics[1].Amount)
+	assert.Equal(t, 2, aliases.calls)
+
+	for _, s := range []string{alice.Address, alice.Pretty()} {
+		address, err := resolver.ResolveAddress(ctx, s)
+		require.NoError(t, err)
+		assert.Equal(t, alice, address)
+	}
+
+	// aliases are cached until alias transactions change them
+	_, err = resolver.ResolveAddress(ctx, "alice.wallet")
+	require.NoError(t, err)
+	assert.Equal(t, 2, aliases.calls)
+
+	bob, err := NewAddressFromRaw("VAWOEOWTABXR7O3ZAK2XNA5GIBNE6PZIXDAFDWBV")
+	require.NoError(t, err)
+	aliases.addresses[*wallet] = bob
+
+	unlink, err := NewAddressAliasTransaction(NewDeadline(time.Hour), alice, wallet, AliasUnlink, PublicTest)
+	require.NoError(t, err)
+	aggregate, err := NewCompleteAggregateTransaction(NewDeadline(time.Hour), []Transaction{unlink}, PublicTest)
+	require.NoError(t, err)
+	resolver.InvalidateTransaction(aggregate)
+
+	address, err := resolver.ResolveAddress(ctx, "alice.wallet")
+	require.NoError(t, err)
+	assert.Equal(t, bob, address)
+	assert.Equal(t, 3, aliases.calls)
+
+	_, err = resolver.ResolveAddress(ctx, "nobody.wallet")
+	assert.Equal(t, ErrNamespaceNotLinked, err)
+
+	aliasAddress, err := NewAddressFromNamespace(wallet)
+	require.NoError(t, err)
+	address, err = resolver.AddressOf(ctx, aliasAddress)
+	require.NoError(t, err)
+	assert.Equal(t, bob, address)
+
+	address, err = resolver.AddressOf(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, alice, address)
+
+	_, err = resolver.ResolveMosaic(ctx, "prx.xpx:0.0000001")
+	assert.Equal(t, ErrInvalidAmount, err)
+}
+
+func TestNameResolver_ZeroValue(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	xpx, err := NewMosaicId(0x0DC67FBE1CAD29E3)
+	require.NoError(t, err)
+	prx, err := NewNamespaceIdFromName("prx.xpx")
+	require.NoError(t, err)
+
+	client := NewClient(nil, config)
+	client.Namespace = &fakeAliases{mosaics: map[NamespaceId]*MosaicId{*prx: xpx}}
+	client.Mosaic = &fakeMosaicInfos{divisibility: 6}
+
+	resolver := &NameResolver{Client: client}
+	mosaic, err := resolver.ResolveMosaic(ctx, "prx.xpx:1")
+	require.NoError(t, err)
+	assert.Equal(t, Amount(1000000), mosaic.Amount)
+}
+
+func TestTtlCache_Sweep(t *testing.T) {
+	c := &ttlCache[int, int]{}
+
+	for i := 0; i < minCacheSweepSize; i++ {
+		c.set(i, i, -time.Second)
+	}
+	assert.Equal(t, minCacheSweepSize, len(c.entries))
+
+	// expired entries are dropped without being read again
+	c.set(minCacheSweepSize, 0, time.Minute)
+	assert.Equal(t, 1, len(c.entries))
+
+	v, ok := c.get(minCacheSweepSize)
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+
+	// alive entries are kept
+	for i := 0; i < minCacheSweepSize*4; i++ {
+		c.set(i, i, time.Minute)
+	}
+	assert.Equal(t, minCacheSweepSize*4, len(c.entries))
+}
+
+func TestParseDecimalAmount(t *testing.T) {
+	for _, tc := range []struct {
+		amount       string
+		divisibility uint8
+		expected     Amount
+		err          error
+	}{
+		{"12.5", 6, 12500000, nil},
+		{"12", 0, 12, nil},
+		{".5", 1, 5, nil},
+		{"0.000001", 6, 1, nil},
+		{"000", 6, 0, nil},
+		{"9223372036854.775807", 6, 9223372036854775807, nil},
+		{"9223372036854.775808", 6, 0, ErrInvalidAmount},
+		{"1.5", 0, 0, ErrInvalidAmount},
+		{"-1", 6, 0, ErrInvalidAmount},
+		{"1,5", 6, 0, ErrInvalidAmount},
+		{".", 6, 0, ErrInvalidAmount},
+		{"", 6, 0, ErrInvalidAmount},
+	} {
+		amount, err := ParseDecimalAmount(tc.amount, tc.divisibility)
+		assert.Equal(t, tc.err, err, tc.amount)
+		assert.Equal(t, tc.expected, amount, tc.amount)
+	}
+}
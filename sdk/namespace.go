@@ -13,10 +13,27 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name NamespaceService --output ../mocks --outpkg mocks
+
 // NamespaceService provides a set of methods for obtaining information about the namespace
-type NamespaceService service
+type NamespaceService interface {
+	GetNamespaceInfo(ctx context.Context, nsId *NamespaceId) (*NamespaceInfo, error)
+	// returns NamespaceInfo's corresponding to passed Address and NamespaceId with maximum limit
+	GetNamespaceInfosFromAccount(ctx context.Context, address *Address, nsId *NamespaceId, pageSize int) ([]*NamespaceInfo, error)
+	// returns NamespaceInfo's corresponding to passed Address's and NamespaceId with maximum limit
+	GetNamespaceInfosFromAccounts(ctx context.Context, addrs []*Address, nsId *NamespaceId, pageSize int) ([]*NamespaceInfo, error)
+	GetNamespaceNames(ctx context.Context, nsIds []*NamespaceId) ([]*NamespaceName, error)
+	// GetLinkedMosaicId
+	// @/namespace/%s
+	GetLinkedMosaicId(ctx context.Context, namespaceId *NamespaceId) (*MosaicId, error)
+	// GetLinkedAddress
+	// @/namespace/%s
+	GetLinkedAddress(ctx context.Context, namespaceId *NamespaceId) (*Address, error)
+}
+
+type namespaceService service
 
-func (ref *NamespaceService) GetNamespaceInfo(ctx context.Context, nsId *NamespaceId) (*NamespaceInfo, error) {
+func (ref *namespaceService) GetNamespaceInfo(ctx context.Context, nsId *NamespaceId) (*NamespaceInfo, error) {
 	if nsId == nil {
 		return nil, ErrNilNamespaceId
 	}
@@ -48,7 +65,7 @@ func (ref *NamespaceService) GetNamespaceInfo(ctx context.Context, nsId *Namespa
 
 // returns NamespaceInfo's corresponding to passed Address and NamespaceId with maximum limit
 // TODO: fix pagination
-func (ref *NamespaceService) GetNamespaceInfosFromAccount(ctx context.Context, address *Address, nsId *NamespaceId,
+func (ref *namespaceService) GetNamespaceInfosFromAccount(ctx context.Context, address *Address, nsId *NamespaceId,
 	pageSize int) ([]*NamespaceInfo, error) {
 	if address == nil {
 		return nil, ErrNilAddress
@@ -89,7 +106,7 @@ func (ref *NamespaceService) GetNamespaceInfosFromAccount(ctx context.Context, a
 
 // returns NamespaceInfo's corresponding to passed Address's and NamespaceId with maximum limit
 // TODO: fix pagination
-func (ref *NamespaceService) GetNamespaceInfosFromAccounts(ctx context.Context, addrs []*Address, nsId *NamespaceId,
+func (ref *namespaceService) GetNamespaceInfosFromAccounts(ctx context.Context, addrs []*Address, nsId *NamespaceId,
 	pageSize int) ([]*NamespaceInfo, error) {
 	if len(addrs) == 0 {
 		return nil, ErrEmptyAddressesIds
@@ -128,7 +145,7 @@ func (ref *NamespaceService) GetNamespaceInfosFromAccounts(ctx context.Context,
 	return nsInfos, nil
 }
 
-func (ref *NamespaceService) GetNamespaceNames(ctx context.Context, nsIds []*NamespaceId) ([]*NamespaceName, error) {
+func (ref *namespaceService) GetNamespaceNames(ctx context.Context, nsIds []*NamespaceId) ([]*NamespaceName, error) {
 	if len(nsIds) == 0 {
 		return nil, ErrEmptyNamespaceIds
 	}
@@ -149,7 +166,7 @@ func (ref *NamespaceService) GetNamespaceNames(ctx context.Context, nsIds []*Nam
 
 // GetLinkedMosaicId
 // @/namespace/%s
-func (ref *NamespaceService) GetLinkedMosaicId(ctx context.Context, namespaceId *NamespaceId) (*MosaicId, error) {
+func (ref *namespaceService) GetLinkedMosaicId(ctx context.Context, namespaceId *NamespaceId) (*MosaicId, error) {
 	if namespaceId == nil {
 		return nil, ErrNilAddress
 	}
@@ -165,7 +182,7 @@ func (ref *NamespaceService) GetLinkedMosaicId(ctx context.Context, namespaceId
 
 // GetLinkedAddress
 // @/namespace/%s
-func (ref *NamespaceService) GetLinkedAddress(ctx context.Context, namespaceId *NamespaceId) (*Address, error) {
+func (ref *namespaceService) GetLinkedAddress(ctx context.Context, namespaceId *NamespaceId) (*Address, error) {
 	if namespaceId == nil {
 		return nil, ErrNilAddress
 	}
@@ -179,7 +196,7 @@ func (ref *NamespaceService) GetLinkedAddress(ctx context.Context, namespaceId *
 	return info.Alias.Address(), nil
 }
 
-func (ref *NamespaceService) buildNamespaceHierarchy(ctx context.Context, nsInfo *NamespaceInfo) error {
+func (ref *namespaceService) buildNamespaceHierarchy(ctx context.Context, nsInfo *NamespaceInfo) error {
 	if nsInfo == nil || nsInfo.Parent == nil {
 		return nil
 	}
@@ -198,7 +215,7 @@ func (ref *NamespaceService) buildNamespaceHierarchy(ctx context.Context, nsInfo
 	return ref.buildNamespaceHierarchy(ctx, nsInfo.Parent)
 }
 
-func (ref *NamespaceService) buildNamespacesHierarchy(ctx context.Context, nsInfos []*NamespaceInfo) error {
+func (ref *namespaceService) buildNamespacesHierarchy(ctx context.Context, nsInfos []*NamespaceInfo) error {
 	var err error
 
 	for _, nsInfo := range nsInfos {
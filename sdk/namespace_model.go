@@ -300,3 +300,17 @@ func NewAddressFromNamespace(namespaceId *NamespaceId) (*Address, error) {
 
 	return NewAddressFromBase32(a)
 }
+
+// returns namespace identifier of an alias address or nil for other addresses
+func NewNamespaceIdFromAddress(address *Address) (*NamespaceId, error) {
+	b, err := address.Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < 9 || NetworkType(b[0]) != AliasAddress {
+		return nil, nil
+	}
+
+	return NewNamespaceId(binary.LittleEndian.Uint64(b[1:9]))
+}
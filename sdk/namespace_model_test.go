@@ -81,3 +81,20 @@ func TestNewAddressFromNamespace(t *testing.T) {
 	assert.Equal(t, address.Type, AliasAddress)
 	assert.Equal(t, "9144B262C46CEABB8500000000000000000000000000000000", parsed)
 }
+
+func TestNewNamespaceIdFromAddress(t *testing.T) {
+	namespaceId := newNamespaceIdPanic(0x85bbea6cc462b244)
+	address, err := NewAddressFromNamespace(namespaceId)
+	assert.Nil(t, err)
+
+	parsed, err := NewNamespaceIdFromAddress(address)
+	assert.Nil(t, err)
+	assert.Equal(t, namespaceId, parsed)
+
+	account, err := NewAccountFromPublicKey("D5E9BFA4D9CC5E5A3CF5BFA5A9D7A9AC2A1C9F8F0E2D9B1C5A4C9E7F3A2B1C0D", MijinTest)
+	assert.Nil(t, err)
+
+	parsed, err = NewNamespaceIdFromAddress(account.Address)
+	assert.Nil(t, err)
+	assert.Nil(t, parsed)
+}
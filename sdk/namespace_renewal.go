@@ -0,0 +1,255 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+const (
+	DefaultNamespaceRenewalThreshold = time.Hour * 24 * 30
+	DefaultNamespaceRenewalPeriod    = time.Hour * 24 * 365
+	DefaultNamespaceReportInterval   = time.Hour
+	namespacesPageSize               = 100
+	// eternalNamespaceHeight is the end height of eternal namespaces, the maximum uint64 read as Height
+	eternalNamespaceHeight Height = -1
+)
+
+// NamespaceExpiry is the remaining lifetime of a root namespace
+type NamespaceExpiry struct {
+	Namespace *NamespaceInfo
+	Name      string
+	// BlocksLeft is zero when the namespace is already expired
+	BlocksLeft Height
+	TimeLeft   time.Duration
+	ExpiresAt  time.Time
+	// Aliases are the root and its children linked to an address or a mosaic,
+	// these aliases stop resolving when the root expires
+	Aliases []*NamespaceInfo
+}
+
+func (e *NamespaceExpiry) Expired() bool {
+	return e.BlocksLeft == 0
+}
+
+// NamespaceExpiryReport lists root namespaces which expire within the threshold
+type NamespaceExpiryReport struct {
+	Time     time.Time
+	Expiring []*NamespaceExpiry
+	Err      error
+}
+
+// NamespaceRenewalManager watches expiry of root namespaces of the owner and builds renewals for them
+type NamespaceRenewalManager struct {
+	Client *Client
+	Owner  *Address
+	// Threshold is the time left below which namespaces are reported and renewed
+	Threshold time.Duration
+	// RenewalPeriod is the time renewals extend namespaces for
+	RenewalPeriod  time.Duration
+	ReportInterval time.Duration
+}
+
+// returns NamespaceRenewalManager with default threshold, renewal period and report interval
+func NewNamespaceRenewalManager(client *Client, owner *Address) *NamespaceRenewalManager {
+	return &NamespaceRenewalManager{
+		Client:         client,
+		Owner:          owner,
+		Threshold:      DefaultNamespaceRenewalThreshold,
+		RenewalPeriod:  DefaultNamespaceRenewalPeriod,
+		ReportInterval: DefaultNamespaceReportInterval,
+	}
+}
+
+// Expiries returns the lifetime of every root namespace of the owner, the soonest expiring first.
+// Eternal namespaces are skipped
+func (m *NamespaceRenewalManager) Expiries(ctx context.Context) ([]*NamespaceExpiry, error) {
+	if m.Owner == nil {
+		return nil, ErrNilAddress
+	}
+
+	infos, err := m.namespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := m.Client.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime, err := m.Client.BlockGenerationTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	roots := make(map[NamespaceId]*NamespaceExpiry)
+	rootIds := make([]*NamespaceId, 0)
+	for _, info := range infos {
+		if info.TypeSpace != Root || info.EndHeight == eternalNamespaceHeight {
+			continue
+		}
+
+		e := &NamespaceExpiry{Namespace: info, Aliases: make([]*NamespaceInfo, 0)}
+		if info.EndHeight > height {
+			e.BlocksLeft = info.EndHeight - height
+		}
+		e.TimeLeft = time.Duration(e.BlocksLeft) * blockTime
+		e.ExpiresAt = now.Add(e.TimeLeft)
+
+		roots[*info.NamespaceId] = e
+		rootIds = append(rootIds, info.NamespaceId)
+	}
+
+	for _, info := range infos {
+		if info.Alias == nil || info.Alias.Type == NoneAliasType || len(info.Levels) == 0 {
+			continue
+		}
+
+		if e, ok := roots[*info.Levels[0]]; ok {
+			e.Aliases = append(e.Aliases, info)
+		}
+	}
+
+	if len(rootIds) == 0 {
+		return []*NamespaceExpiry{}, nil
+	}
+
+	names, err := m.Client.Namespace.GetNamespaceNames(ctx, rootIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		if e, ok := roots[*name.NamespaceId]; ok {
+			e.Name = name.FullName
+		}
+	}
+
+	expiries := make([]*NamespaceExpiry, 0, len(roots))
+	for _, id := range rootIds {
+		expiries = append(expiries, roots[*id])
+	}
+
+	sort.SliceStable(expiries, func(i, j int) bool {
+		return expiries[i].BlocksLeft < expiries[j].BlocksLeft
+	})
+
+	return expiries, nil
+}
+
+// Expiring returns root namespaces of the owner which expire within the threshold
+func (m *NamespaceRenewalManager) Expiring(ctx context.Context) ([]*NamespaceExpiry, error) {
+	expiries, err := m.Expiries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expiring := make([]*NamespaceExpiry, 0)
+	for _, e := range expiries {
+		if e.TimeLeft < m.Threshold {
+			expiring = append(expiring, e)
+		}
+	}
+
+	return expiring, nil
+}
+
+// Renewals returns transactions which extend passed namespaces for RenewalPeriod. They are signed by the owner
+func (m *NamespaceRenewalManager) Renewals(ctx context.Context, expiries []*NamespaceExpiry) ([]*RegisterNamespaceTransaction, error) {
+	blockTime, err := m.Client.BlockGenerationTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := Duration(m.RenewalPeriod / blockTime)
+	if duration == 0 {
+		return nil, ErrArgumentNotValid
+	}
+
+	deadline, err := m.Client.NewDeadline(time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*RegisterNamespaceTransaction, 0, len(expiries))
+	for _, e := range expiries {
+		if e.Name == "" {
+			return nil, ErrInvalidNamespaceName
+		}
+
+		tx, err := m.Client.NewRegisterRootNamespaceTransaction(deadline, e.Name, duration)
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// Report returns root namespaces which expire within the threshold
+func (m *NamespaceRenewalManager) Report(ctx context.Context) *NamespaceExpiryReport {
+	expiring, err := m.Expiring(ctx)
+
+	return &NamespaceExpiryReport{
+		Time:     time.Now(),
+		Expiring: expiring,
+		Err:      err,
+	}
+}
+
+// Monitor sends a report every ReportInterval until ctx is done
+func (m *NamespaceRenewalManager) Monitor(ctx context.Context) <-chan *NamespaceExpiryReport {
+	reports := make(chan *NamespaceExpiryReport)
+
+	go func() {
+		defer close(reports)
+
+		ticker := time.NewTicker(m.ReportInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case reports <- m.Report(ctx):
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return reports
+}
+
+func (m *NamespaceRenewalManager) namespaces(ctx context.Context) ([]*NamespaceInfo, error) {
+	infos := make([]*NamespaceInfo, 0)
+	var last *NamespaceId
+	for {
+		page, err := m.Client.Namespace.GetNamespaceInfosFromAccount(ctx, m.Owner, last, namespacesPageSize)
+		if err != nil {
+			if IsNotFound(err) {
+				return infos, nil
+			}
+			return nil, err
+		}
+
+		infos = append(infos, page...)
+
+		if len(page) < namespacesPageSize {
+			return infos, nil
+		}
+		last = page[len(page)-1].NamespaceId
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOwnedNamespaces struct {
+	NamespaceService
+	infos []*NamespaceInfo
+	names map[NamespaceId]string
+	pages int
+}
+
+func (s *fakeOwnedNamespaces) GetNamespaceInfosFromAccount(_ context.Context, _ *Address, nsId *NamespaceId, pageSize int) ([]*NamespaceInfo, error) {
+	s.pages++
+
+	start := 0
+	if nsId != nil {
+		for i, info := range s.infos {
+			if *info.NamespaceId == *nsId {
+				start = i + 1
+			}
+		}
+	}
+
+	end := start + pageSize
+	if end > len(s.infos) {
+		end = len(s.infos)
+	}
+
+	return s.infos[start:end], nil
+}
+
+func (s *fakeOwnedNamespaces) GetNamespaceNames(_ context.Context, nsIds []*NamespaceId) ([]*NamespaceName, error) {
+	names := make([]*NamespaceName, 0, len(nsIds))
+	for _, id := range nsIds {
+		names = append(names, &NamespaceName{NamespaceId: id, FullName: s.names[*id]})
+	}
+
+	return names, nil
+}
+
+type fakeChainHeight struct {
+	BlockchainService
+	height Height
+}
+
+func (s *fakeChainHeight) GetBlockchainHeight(context.Context) (Height, error) {
+	return s.height, nil
+}
+
+func (s *fakeOwnedNamespaces) add(t *testing.T, name string, end Height, alias *NamespaceAlias) *NamespaceInfo {
+	path, err := GenerateNamespacePath(name)
+	require.NoError(t, err)
+
+	info := &NamespaceInfo{
+		NamespaceId: path[len(path)-1],
+		Active:      true,
+		TypeSpace:   Root,
+		Depth:       len(path),
+		Levels:      path,
+		Alias:       alias,
+		EndHeight:   end,
+	}
+	if len(path) > 1 {
+		info.TypeSpace = Sub
+	}
+
+	s.infos = append(s.infos, info)
+	s.names[*info.NamespaceId] = name
+
+	return info
+}
+
+func TestNamespaceRenewalManager_Expiring(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	namespaces := &fakeOwnedNamespaces{names: make(map[NamespaceId]string)}
+	client := NewClient(nil, config)
+	client.Namespace = namespaces
+	client.Blockchain = &fakeChainHeight{height: 1000}
+	client.Network = &fakeNetworkConfigService{config: "[chain]\n\nblockGenerationTargetTime = 15s\n"}
+	markSynced(client)
+
+	owner, err := NewAddressFromRaw("VAWOEOWTABXR7O3ZAK2XNA5GIBNE6PZIXDAFDWBU")
+	require.NoError(t, err)
+	mosaicId, err := NewMosaicId(0x1234)
+	require.NoError(t, err)
+
+	// 240 blocks are an hour
+	soon := namespaces.add(t, "soon", 1240, nil)
+	aliased := namespaces.add(t, "soon.wallet", 1240, &NamespaceAlias{address: owner, Type: AddressAliasType})
+	namespaces.add(t, "soon.empty", 1240, &NamespaceAlias{Type: NoneAliasType})
+	namespaces.add(t, "later", 1000+240*24*60, &NamespaceAlias{mosaicId: mosaicId, Type: MosaicAliasType})
+	expired := namespaces.add(t, "expired", 900, nil)
+	namespaces.add(t, "eternal", eternalNamespaceHeight, nil)
+	for i := 0; i < namespacesPageSize; i++ {
+		namespaces.add(t, "soon.sub"+string(rune('a'+i%26))+string(rune('a'+i/26)), 1240, nil)
+	}
+
+	manager := NewNamespaceRenewalManager(client, owner)
+
+	expiries, err := manager.Expiries(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, namespaces.pages)
+	require.Len(t, expiries, 3)
+
+	assert.Equal(t, expired, expiries[0].Namespace)
+	assert.True(t, expiries[0].Expired())
+
+	assert.Equal(t, soon, expiries[1].Namespace)
+	assert.Equal(t, "soon", expiries[1].Name)
+	assert.Equal(t, Height(240), expiries[1].BlocksLeft)
+	assert.Equal(t, time.Hour, expiries[1].TimeLeft)
+	assert.Equal(t, []*NamespaceInfo{aliased}, expiries[1].Aliases)
+
+	assert.Equal(t, "later", expiries[2].Name)
+	assert.Len(t, expiries[2].Aliases, 1)
+
+	expiring, err := manager.Expiring(ctx)
+	require.NoError(t, err)
+	require.Len(t, expiring, 2)
+
+	manager.RenewalPeriod = time.Hour * 24
+	renewals, err := manager.Renewals(ctx, expiring)
+	require.NoError(t, err)
+	require.Len(t, renewals, 2)
+	assert.Equal(t, "expired", renewals[0].NamspaceName)
+	assert.Equal(t, "soon", renewals[1].NamspaceName)
+	assert.Equal(t, Duration(240*24), renewals[1].Duration)
+	assert.Equal(t, Root, renewals[1].NamespaceType)
+}
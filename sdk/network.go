@@ -11,12 +11,23 @@ import (
 	"net/http"
 )
 
-type NetworkService struct {
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name NetworkService --output ../mocks --outpkg mocks
+
+// NetworkService provides network type, version and config REST endpoints
+type NetworkService interface {
+	GetNetworkType(ctx context.Context) (NetworkType, error)
+	GetNetworkConfigAtHeight(ctx context.Context, height Height) (*BlockchainConfig, error)
+	GetNetworkConfig(ctx context.Context) (*BlockchainConfig, error)
+	GetNetworkVersionAtHeight(ctx context.Context, height Height) (*NetworkVersion, error)
+	GetNetworkVersion(ctx context.Context) (*NetworkVersion, error)
+}
+
+type networkService struct {
 	*service
-	BlockchainService *BlockchainService
+	BlockchainService BlockchainService
 }
 
-func (ref *NetworkService) GetNetworkType(ctx context.Context) (NetworkType, error) {
+func (ref *networkService) GetNetworkType(ctx context.Context) (NetworkType, error) {
 	netDTO := &networkDTO{}
 
 	resp, err := ref.client.doNewRequest(ctx, http.MethodGet, networkRoute, nil, netDTO)
@@ -38,7 +49,7 @@ func (ref *NetworkService) GetNetworkType(ctx context.Context) (NetworkType, err
 	return networkType, err
 }
 
-func (ref *NetworkService) GetNetworkConfigAtHeight(ctx context.Context, height Height) (*BlockchainConfig, error) {
+func (ref *networkService) GetNetworkConfigAtHeight(ctx context.Context, height Height) (*BlockchainConfig, error) {
 	blockchainDTO := &blockchainConfigDTO{}
 
 	url := fmt.Sprintf(configRoute, height)
@@ -56,7 +67,7 @@ func (ref *NetworkService) GetNetworkConfigAtHeight(ctx context.Context, height
 	return blockchainDTO.toStruct()
 }
 
-func (ref *NetworkService) GetNetworkConfig(ctx context.Context) (*BlockchainConfig, error) {
+func (ref *networkService) GetNetworkConfig(ctx context.Context) (*BlockchainConfig, error) {
 	height, err := ref.BlockchainService.GetBlockchainHeight(ctx)
 	if err != nil {
 		return nil, err
@@ -65,7 +76,7 @@ func (ref *NetworkService) GetNetworkConfig(ctx context.Context) (*BlockchainCon
 	return ref.GetNetworkConfigAtHeight(ctx, height)
 }
 
-func (ref *NetworkService) GetNetworkVersionAtHeight(ctx context.Context, height Height) (*NetworkVersion, error) {
+func (ref *networkService) GetNetworkVersionAtHeight(ctx context.Context, height Height) (*NetworkVersion, error) {
 	netDTO := &networkVersionDTO{}
 
 	url := fmt.Sprintf(upgradeRoute, height)
@@ -83,7 +94,7 @@ func (ref *NetworkService) GetNetworkVersionAtHeight(ctx context.Context, height
 	return netDTO.toStruct(), nil
 }
 
-func (ref *NetworkService) GetNetworkVersion(ctx context.Context) (*NetworkVersion, error) {
+func (ref *networkService) GetNetworkVersion(ctx context.Context) (*NetworkVersion, error) {
 	height, err := ref.BlockchainService.GetBlockchainHeight(ctx)
 	if err != nil {
 		return nil, err
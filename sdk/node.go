@@ -10,9 +10,18 @@ import (
 	"net/http"
 )
 
-type NodeService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name NodeService --output ../mocks --outpkg mocks
 
-func (s *NodeService) GetNodeInfo(ctx context.Context) (*NodeInfo, error) {
+// NodeService provides node REST endpoints
+type NodeService interface {
+	GetNodeInfo(ctx context.Context) (*NodeInfo, error)
+	GetNodeTime(ctx context.Context) (*BlockchainTimestamp, error)
+	GetNodePeers(ctx context.Context) ([]*NodeInfo, error)
+}
+
+type nodeService service
+
+func (s *nodeService) GetNodeInfo(ctx context.Context) (*NodeInfo, error) {
 	url := net.NewUrl(nodeInfoRoute)
 
 	dto := &nodeInfoDTO{}
@@ -29,7 +38,7 @@ func (s *NodeService) GetNodeInfo(ctx context.Context) (*NodeInfo, error) {
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *NodeService) GetNodeTime(ctx context.Context) (*BlockchainTimestamp, error) {
+func (s *nodeService) GetNodeTime(ctx context.Context) (*BlockchainTimestamp, error) {
 	url := net.NewUrl(nodeTimeRoute)
 
 	dto := &timeDTO{}
@@ -48,7 +57,7 @@ func (s *NodeService) GetNodeTime(ctx context.Context) (*BlockchainTimestamp, er
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *NodeService) GetNodePeers(ctx context.Context) ([]*NodeInfo, error) {
+func (s *nodeService) GetNodePeers(ctx context.Context) ([]*NodeInfo, error) {
 	url := net.NewUrl(nodePeersRoute)
 
 	dto := &nodeInfoDTOs{}
@@ -0,0 +1,247 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultDiscoveryRefreshInterval = time.Minute * 5
+	DefaultDiscoveryProbeTimeout    = time.Second * 5
+	DefaultDiscoveryMaxNodes        = 10
+	DefaultDiscoveryRestPort        = 3000
+	DefaultDiscoveryScheme          = "http"
+)
+
+// NodeDiscoveryConfig configures NodeDiscovery. Zero values are replaced by defaults
+type NodeDiscoveryConfig struct {
+	RefreshInterval time.Duration
+	ProbeTimeout    time.Duration
+	// MaxNodes limits the number of discovered nodes added to Config.BaseURLs. Seed urls are not counted
+	MaxNodes int
+	// RestPort and Scheme are used to build REST urls of peers, because peers report only their p2p port
+	RestPort int
+	Scheme   string
+	// Allow contains hosts or host:port pairs which can be added. Empty Allow allows all hosts
+	Allow []string
+	// Deny contains hosts or host:port pairs which are never added
+	Deny []string
+}
+
+func (cfg *NodeDiscoveryConfig) withDefaults() NodeDiscoveryConfig {
+	c := *cfg
+
+	if c.RefreshInterval <= 0 {
+		c.RefreshInterval = DefaultDiscoveryRefreshInterval
+	}
+
+	if c.ProbeTimeout <= 0 {
+		c.ProbeTimeout = DefaultDiscoveryProbeTimeout
+	}
+
+	if c.MaxNodes <= 0 {
+		c.MaxNodes = DefaultDiscoveryMaxNodes
+	}
+
+	if c.RestPort <= 0 {
+		c.RestPort = DefaultDiscoveryRestPort
+	}
+
+	if c.Scheme == "" {
+		c.Scheme = DefaultDiscoveryScheme
+	}
+
+	return c
+}
+
+// NodeDiscovery finds API nodes of the same network through NodeService.GetNodePeers
+// and adds healthy ones to the client endpoints, so a single seed url is enough
+type NodeDiscovery struct {
+	client *Client
+	config NodeDiscoveryConfig
+
+	m          sync.Mutex
+	seeds      []url.URL
+	discovered []url.URL
+}
+
+func NewNodeDiscovery(client *Client, config NodeDiscoveryConfig) *NodeDiscovery {
+	return &NodeDiscovery{
+		client: client,
+		config: config.withDefaults(),
+		seeds:  client.config.GetBaseURLs(),
+	}
+}
+
+// Nodes returns urls of currently discovered nodes
+func (d *NodeDiscovery) Nodes() []url.URL {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	return append([]url.URL(nil), d.discovered...)
+}
+
+// Run refreshes nodes every RefreshInterval until ctx is done
+func (d *NodeDiscovery) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		// a failed refresh keeps previously discovered nodes, so just wait for the next one
+		_, _ = d.Refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh requests peers, probes API nodes of the same network and replaces previously discovered nodes
+// in Config.BaseURLs with healthy ones. It returns urls of discovered nodes
+func (d *NodeDiscovery) Refresh(ctx context.Context) ([]url.URL, error) {
+	generationHash, err := d.generationHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers, err := d.client.Node.GetNodePeers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// GetNodePeers hides connection errors, keep discovered nodes in this case
+	if peers == nil {
+		return nil, ErrNoNodePeers
+	}
+
+	healthy := make([]url.URL, 0, d.config.MaxNodes)
+	for _, peer := range peers {
+		if len(healthy) == d.config.MaxNodes {
+			break
+		}
+
+		u, ok := d.candidate(peer)
+		if !ok || containsURL(d.seeds, u) || containsURL(healthy, u) {
+			continue
+		}
+
+		if err := d.probe(ctx, u, generationHash); err != nil {
+			continue
+		}
+
+		healthy = append(healthy, u)
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	stale := make([]url.URL, 0, len(d.discovered))
+	for _, u := range d.discovered {
+		if !containsURL(healthy, u) {
+			stale = append(stale, u)
+		}
+	}
+
+	d.client.config.RemoveBaseURLs(stale...)
+	d.client.config.AddBaseURLs(healthy...)
+	d.discovered = healthy
+
+	return append([]url.URL(nil), healthy...), nil
+}
+
+func (d *NodeDiscovery) generationHash(ctx context.Context) (*Hash, error) {
+	if hash := d.client.GenerationHash(); hash != nil {
+		return hash, nil
+	}
+
+	block, err := d.client.Blockchain.GetBlockByHeight(ctx, Height(1))
+	if err != nil {
+		return nil, err
+	}
+
+	return block.GenerationHash, nil
+}
+
+func (d *NodeDiscovery) candidate(peer *NodeInfo) (url.URL, bool) {
+	if peer == nil || peer.Host == "" || !peer.isApiNode() || peer.NetworkType != d.client.NetworkType() {
+		return url.URL{}, false
+	}
+
+	u := url.URL{
+		Scheme: d.config.Scheme,
+		Host:   net.JoinHostPort(peer.Host, strconv.Itoa(d.config.RestPort)),
+	}
+
+	if matchHost(d.config.Deny, u) {
+		return url.URL{}, false
+	}
+
+	if len(d.config.Allow) > 0 && !matchHost(d.config.Allow, u) {
+		return url.URL{}, false
+	}
+
+	return u, true
+}
+
+// probe checks that node at u answers and belongs to the network with generationHash
+func (d *NodeDiscovery) probe(ctx context.Context, u url.URL, generationHash *Hash) error {
+	conf, err := NewConfigWithReputation(
+		[]string{u.String()},
+		d.client.NetworkType(),
+		d.client.config.reputationConfig,
+		d.client.config.WsReconnectionTimeout,
+		generationHash,
+		d.client.config.FeeCalculationStrategy,
+	)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.config.ProbeTimeout)
+	defer cancel()
+
+	probe := NewClient(&http.Client{Timeout: d.config.ProbeTimeout}, conf)
+
+	block, err := probe.Blockchain.GetBlockByHeight(ctx, Height(1))
+	if err != nil {
+		return err
+	}
+
+	if block.GenerationHash == nil || !block.GenerationHash.Equal(generationHash) {
+		return fmt.Errorf("%s: %w", u.String(), ErrGenerationHashMismatch)
+	}
+
+	return nil
+}
+
+func matchHost(list []string, u url.URL) bool {
+	for _, h := range list {
+		if h == u.Host || h == u.Hostname() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsURL(urls []url.URL, u url.URL) bool {
+	for _, v := range urls {
+		if v == u {
+			return true
+		}
+	}
+
+	return false
+}
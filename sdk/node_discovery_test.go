@@ -0,0 +1,128 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDiscoveryGenerationHash = "8EC49BBADB3B2FD90810DB9BDACF1FDE999295C594B5FD4B584A0A72F5AAFA59"
+
+func newDiscoveryNode(t *testing.T, addr string, blockJson string, peers string) *httptest.Server {
+	l, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf(blockByHeightRoute, Height(1)), func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(blockJson))
+	})
+	mux.HandleFunc(nodePeersRoute, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(peers))
+	})
+
+	s := httptest.NewUnstartedServer(mux)
+	s.Listener.Close()
+	s.Listener = l
+	s.Start()
+
+	return s
+}
+
+func testPeerJson(host string, roles int, networkType NetworkType) string {
+	return fmt.Sprintf(
+		`{"publicKey": "%s", "port": 7900, "networkIdentifier": %d, "version": 0, "roles": %d, "host": "%s", "friendlyName": "%s"}`,
+		testPublicKey.PublicKey, networkType, roles, host, host,
+	)
+}
+
+func TestNodeDiscovery_Refresh(t *testing.T) {
+	healthy := newDiscoveryNode(t, "127.0.0.1:0", blockInfoJSON, "[]")
+	defer healthy.Close()
+
+	_, port, err := net.SplitHostPort(healthy.Listener.Addr().String())
+	require.NoError(t, err)
+	restPort, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	otherNetwork := newDiscoveryNode(
+		t,
+		net.JoinHostPort("127.0.0.2", port),
+		strings.Replace(blockInfoJSON, testDiscoveryGenerationHash, strings.Repeat("0", 64), 1),
+		"[]",
+	)
+	defer otherNetwork.Close()
+
+	peers := []string{
+		testPeerJson("127.0.0.1", int(Peer|Api), PublicTest),
+		testPeerJson("127.0.0.2", int(Api), PublicTest),
+		testPeerJson("localhost", int(Api), PublicTest),
+		testPeerJson("127.0.0.3", int(Peer), PublicTest),
+		testPeerJson("127.0.0.4", int(Api), MijinTest),
+	}
+	seed := newDiscoveryNode(t, "127.0.0.1:0", blockInfoJSON, "["+strings.Join(peers, ",")+"]")
+	defer seed.Close()
+
+	generationHash, err := StringToHash(testDiscoveryGenerationHash)
+	require.NoError(t, err)
+
+	conf, err := NewConfigWithReputation(
+		[]string{seed.URL},
+		PublicTest,
+		&defaultRepConfig,
+		DefaultWebsocketReconnectionTimeout,
+		generationHash,
+		DefaultFeeCalculationStrategy,
+	)
+	require.NoError(t, err)
+
+	client := NewClient(nil, conf)
+	discovery := NewNodeDiscovery(client, NodeDiscoveryConfig{
+		RestPort: restPort,
+		Deny:     []string{"localhost"},
+	})
+
+	healthyUrl := url.URL{Scheme: "http", Host: healthy.Listener.Addr().String()}
+	seedUrl, err := url.Parse(seed.URL)
+	require.NoError(t, err)
+
+	nodes, err := discovery.Refresh(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []url.URL{healthyUrl}, nodes)
+	assert.Equal(t, []url.URL{*seedUrl, healthyUrl}, conf.GetBaseURLs())
+
+	healthy.Close()
+
+	nodes, err = discovery.Refresh(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+	assert.Equal(t, []url.URL{*seedUrl}, conf.GetBaseURLs())
+}
+
+func TestNodeDiscovery_Candidate(t *testing.T) {
+	client := NewClient(nil, &Config{NetworkType: PublicTest})
+	discovery := NewNodeDiscovery(client, NodeDiscoveryConfig{
+		Allow: []string{"api-1", "api-2:3000"},
+		Deny:  []string{"api-2"},
+	})
+
+	_, ok := discovery.candidate(&NodeInfo{Host: "api-1", Roles: int(Api), NetworkType: PublicTest})
+	assert.True(t, ok)
+
+	_, ok = discovery.candidate(&NodeInfo{Host: "api-2", Roles: int(Api), NetworkType: PublicTest})
+	assert.False(t, ok)
+
+	_, ok = discovery.candidate(&NodeInfo{Host: "api-3", Roles: int(Api), NetworkType: PublicTest})
+	assert.False(t, ok)
+}
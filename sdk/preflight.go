@@ -0,0 +1,503 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PreflightFinding is a check which the transaction is expected to fail on the network
+type PreflightFinding struct {
+	// Err is the status error the network is expected to reject the transaction with
+	Err error
+	// Transaction is the failing transaction, it is an inner transaction for aggregates
+	Transaction Transaction
+	Message     string
+}
+
+func (f *PreflightFinding) String() string {
+	return fmt.Sprintf("%s: %s", f.Transaction.GetAbstractTransaction().Type, f.Message)
+}
+
+// PreflightReport is the result of pre-flight checks of a transaction
+type PreflightReport struct {
+	Findings []*PreflightFinding
+}
+
+// Ok returns true when no check failed
+func (r *PreflightReport) Ok() bool {
+	return len(r.Findings) == 0
+}
+
+// Err returns the status error of the first finding or nil
+func (r *PreflightReport) Err() error {
+	if r.Ok() {
+		return nil
+	}
+
+	return r.Findings[0].Err
+}
+
+// PreflightValidator checks transactions against the current state of the chain before they are signed.
+// It covers common stateful checks: deadline, multisig signers, account properties, namespace ownership and expiry,
+// mosaic properties and balances including levies and fees. Passing the checks does not guarantee confirmation
+type PreflightValidator struct {
+	Client *Client
+	// Costs calculates transferred amounts with levies and fees for balance checks
+	Costs *TransferCostCalculator
+}
+
+// returns PreflightValidator
+func NewPreflightValidator(client *Client) *PreflightValidator {
+	return &PreflightValidator{
+		Client: client,
+		Costs:  NewTransferCostCalculator(client),
+	}
+}
+
+// Validate fetches the state the transaction depends on and returns findings of failed checks.
+// Inner transactions of aggregates without a signer are checked with the signer of the aggregate
+func (v *PreflightValidator) Validate(ctx context.Context, tx Transaction, signer *PublicAccount) (*PreflightReport, error) {
+	if tx == nil {
+		return nil, ErrArgumentNotValid
+	}
+
+	if signer == nil {
+		return nil, ErrNilAccount
+	}
+
+	height, err := v.Client.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &preflight{
+		PreflightValidator: v,
+		report:             &PreflightReport{Findings: make([]*PreflightFinding, 0)},
+		height:             height,
+		properties:         make(map[string]*AccountProperties),
+		mosaics:            make(map[MosaicId]*MosaicInfo),
+		namespaces:         make(map[NamespaceId]*NamespaceInfo),
+		transfers:          make(map[string][]*TransferTransaction),
+	}
+
+	if err := p.checkDeadline(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if err := p.checkMultisig(ctx, tx, signer); err != nil {
+		return nil, err
+	}
+
+	if err := p.check(ctx, tx, signer); err != nil {
+		return nil, err
+	}
+
+	if err := p.checkBalances(ctx, tx, signer); err != nil {
+		return nil, err
+	}
+
+	return p.report, nil
+}
+
+// preflight is the state of a single validation
+type preflight struct {
+	*PreflightValidator
+	report     *PreflightReport
+	height     Height
+	properties map[string]*AccountProperties
+	mosaics    map[MosaicId]*MosaicInfo
+	namespaces map[NamespaceId]*NamespaceInfo
+	// senders are in order of their first transfer, transfers are grouped by public keys of senders
+	senders   []*PublicAccount
+	transfers map[string][]*TransferTransaction
+}
+
+func (p *preflight) fail(tx Transaction, err error, format string, args ...interface{}) {
+	p.report.Findings = append(p.report.Findings, &PreflightFinding{
+		Err:         err,
+		Transaction: tx,
+		Message:     fmt.Sprintf(format, args...),
+	})
+}
+
+func (p *preflight) checkDeadline(ctx context.Context, tx Transaction) error {
+	deadline := tx.GetAbstractTransaction().Deadline
+	if deadline == nil {
+		return nil
+	}
+
+	lifetime, err := p.Client.MaxTransactionLifetime(ctx, tx.GetAbstractTransaction().Type)
+	if err != nil {
+		return err
+	}
+
+	left := deadline.Time.Sub(p.Client.TimeSync.Now())
+	switch {
+	case left <= 0:
+		p.fail(tx, ErrCorePastDeadline, "deadline %s is in the past", deadline.Time)
+	case left > lifetime:
+		p.fail(tx, ErrCoreFutureDeadline, "deadline %s is further than max transaction lifetime %s", deadline.Time, lifetime)
+	}
+
+	return nil
+}
+
+// checkMultisig fails when the signer is a multisig account, which can only be used in inner transactions
+func (p *preflight) checkMultisig(ctx context.Context, tx Transaction, signer *PublicAccount) error {
+	info, err := p.Client.Account.GetMultisigAccountInfo(ctx, signer.Address)
+	switch {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if len(info.Cosignatories) > 0 {
+		p.fail(tx, ErrMultisigOperationNotPermittedByAccount, "signer %s is a multisig account", signer.Address.Address)
+	}
+
+	return nil
+}
+
+func (p *preflight) check(ctx context.Context, tx Transaction, signer *PublicAccount) error {
+	entityType := tx.GetAbstractTransaction().Type
+	properties, err := p.accountProperties(ctx, signer.Address)
+	if err != nil {
+		return err
+	}
+
+	if !NewAccountRestrictionPolicy(properties).EntityTypeAllowed(entityType) {
+		p.fail(tx, ErrPropertyTransactionTypeNotAllowed, "signer %s is not allowed to send %s", signer.Address.Address, entityType)
+	}
+
+	switch tx := tx.(type) {
+	case *AggregateTransaction:
+		for _, inner := range tx.InnerTransactions {
+			innerSigner := inner.GetAbstractTransaction().Signer
+			if innerSigner == nil {
+				innerSigner = signer
+			}
+
+			if err := p.check(ctx, inner, innerSigner); err != nil {
+				return err
+			}
+		}
+	case *TransferTransaction:
+		return p.checkTransfer(ctx, tx, signer)
+	case *RegisterNamespaceTransaction:
+		return p.checkRegistration(ctx, tx, signer)
+	case *AddressAliasTransaction:
+		return p.checkAlias(ctx, tx, tx.NamespaceId, signer)
+	case *MosaicAliasTransaction:
+		return p.checkAlias(ctx, tx, tx.NamespaceId, signer)
+	case *MosaicSupplyChangeTransaction:
+		return p.checkSupplyChange(ctx, tx, signer)
+	}
+
+	return nil
+}
+
+func (p *preflight) checkTransfer(ctx context.Context, tx *TransferTransaction, sender *PublicAccount) error {
+	if _, ok := p.transfers[sender.PublicKey]; !ok {
+		p.senders = append(p.senders, sender)
+	}
+	p.transfers[sender.PublicKey] = append(p.transfers[sender.PublicKey], tx)
+
+	if tx.Recipient == nil {
+		p.fail(tx, ErrNilAddress, "recipient is nil")
+		return nil
+	}
+
+	aliasId, err := NewNamespaceIdFromAddress(tx.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if aliasId != nil {
+		if ok, err := p.checkNamespaceActive(ctx, tx, aliasId); err != nil || !ok {
+			return err
+		}
+	}
+
+	recipient, err := p.Costs.Resolver.AddressOf(ctx, tx.Recipient)
+	if errors.Is(err, ErrNamespaceNotLinked) {
+		p.fail(tx, err, "recipient namespace %s is not linked to an address", aliasId)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	properties, err := p.accountProperties(ctx, recipient)
+	if err != nil {
+		return err
+	}
+	policy := NewAccountRestrictionPolicy(properties)
+
+	if !policy.AddressAllowed(sender.Address) {
+		p.fail(tx, ErrPropertySignerAddressInteractionNotAllowed, "recipient %s does not accept transactions of %s", recipient.Address, sender.Address.Address)
+	}
+
+	for _, m := range tx.Mosaics {
+		if namespaceId, ok := m.AssetId.(*NamespaceId); ok {
+			active, err := p.checkNamespaceActive(ctx, tx, namespaceId)
+			if err != nil {
+				return err
+			}
+
+			if !active {
+				continue
+			}
+		}
+
+		mosaicId, err := p.Costs.Resolver.MosaicIdOf(ctx, m.AssetId)
+		if errors.Is(err, ErrNamespaceNotLinked) {
+			p.fail(tx, err, "namespace %s is not linked to a mosaic", m.AssetId)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if !policy.MosaicAllowed(mosaicId) {
+			p.fail(tx, ErrPropertyMosaicTransferNotAllowed, "recipient %s does not accept mosaic %s", recipient.Address, mosaicId)
+		}
+
+		info, err := p.mosaicActive(ctx, tx, mosaicId)
+		if err != nil || info == nil {
+			return err
+		}
+
+		if info.Properties != nil && !info.Properties.Transferable && info.Owner != nil &&
+			!samePublicKey(info.Owner.PublicKey, sender.PublicKey) && info.Owner.Address.Address != recipient.Address {
+			p.fail(tx, ErrMosaicNonTransferable, "mosaic %s can only be transferred to or from its owner", mosaicId)
+		}
+	}
+
+	return nil
+}
+
+func (p *preflight) checkRegistration(ctx context.Context, tx *RegisterNamespaceTransaction, signer *PublicAccount) error {
+	if tx.NamespaceType == Root {
+		info, err := p.namespace(ctx, tx.NamespaceId)
+		if err != nil || info == nil {
+			return err
+		}
+
+		if !p.namespaceExpired(info) && info.Owner != nil && !samePublicKey(info.Owner.PublicKey, signer.PublicKey) {
+			p.fail(tx, ErrNamespaceOwnerConflict, "namespace %s is owned by %s", tx.NamspaceName, info.Owner.Address.Address)
+		}
+
+		return nil
+	}
+
+	parent, err := p.namespace(ctx, tx.ParentId)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case parent == nil:
+		p.fail(tx, ErrNamespaceParentUnknown, "parent namespace %s is unknown", tx.ParentId)
+	case p.namespaceExpired(parent):
+		p.fail(tx, ErrNamespaceExpired, "parent namespace %s is expired", tx.ParentId)
+	case parent.Owner != nil && !samePublicKey(parent.Owner.PublicKey, signer.PublicKey):
+		p.fail(tx, ErrNamespaceOwnerConflict, "parent namespace %s is owned by %s", tx.ParentId, parent.Owner.Address.Address)
+	}
+
+	return nil
+}
+
+func (p *preflight) checkAlias(ctx context.Context, tx Transaction, namespaceId *NamespaceId, signer *PublicAccount) error {
+	info, err := p.namespace(ctx, namespaceId)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case info == nil:
+		p.fail(tx, ErrNamespaceAliasNamespaceUnknown, "namespace %s is unknown", namespaceId)
+	case p.namespaceExpired(info):
+		p.fail(tx, ErrNamespaceExpired, "namespace %s is expired", namespaceId)
+	case info.Owner != nil && !samePublicKey(info.Owner.PublicKey, signer.PublicKey):
+		p.fail(tx, ErrNamespaceAliasOwnerConflict, "namespace %s is owned by %s", namespaceId, info.Owner.Address.Address)
+	}
+
+	return nil
+}
+
+func (p *preflight) checkSupplyChange(ctx context.Context, tx *MosaicSupplyChangeTransaction, signer *PublicAccount) error {
+	mosaicId, err := p.Costs.Resolver.MosaicIdOf(ctx, tx.AssetId)
+	if errors.Is(err, ErrNamespaceNotLinked) {
+		p.fail(tx, err, "namespace %s is not linked to a mosaic", tx.AssetId)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	info, err := p.mosaicActive(ctx, tx, mosaicId)
+	if err != nil || info == nil {
+		return err
+	}
+
+	switch {
+	case info.Owner != nil && !samePublicKey(info.Owner.PublicKey, signer.PublicKey):
+		p.fail(tx, ErrMosaicOwnerConflict, "mosaic %s is owned by %s", mosaicId, info.Owner.Address.Address)
+	case info.Properties != nil && !info.Properties.SupplyMutable:
+		p.fail(tx, ErrMosaicSupplyImmutable, "supply of mosaic %s is immutable", mosaicId)
+	case tx.MosaicSupplyType == Decrease && tx.Delta > info.Supply:
+		p.fail(tx, ErrMosaicSupplyNegative, "supply %d of mosaic %s is less than %d", info.Supply, mosaicId, tx.Delta)
+	}
+
+	return nil
+}
+
+// checkBalances compares transferred mosaics, levies and the fee with balances of every sender
+func (p *preflight) checkBalances(ctx context.Context, tx Transaction, signer *PublicAccount) error {
+	fee := tx.GetAbstractTransaction().MaxFee
+	if _, ok := p.transfers[signer.PublicKey]; !ok && fee > 0 {
+		p.senders = append(p.senders, signer)
+	}
+
+	for _, sender := range p.senders {
+		transfers := make([]*TransferTransaction, 0, len(p.transfers[sender.PublicKey])+1)
+		for _, t := range p.transfers[sender.PublicKey] {
+			// the fee is paid once by the signer
+			withoutFee := *t
+			withoutFee.MaxFee = 0
+			transfers = append(transfers, &withoutFee)
+		}
+
+		if samePublicKey(sender.PublicKey, signer.PublicKey) && fee > 0 {
+			transfers = append(transfers, &TransferTransaction{
+				AbstractTransaction: AbstractTransaction{MaxFee: fee},
+				Recipient:           signer.Address,
+				Mosaics:             []*Mosaic{},
+			})
+		}
+
+		cost, err := p.Costs.Cost(ctx, sender.Address, transfers...)
+		// unlinked namespaces are already reported
+		if errors.Is(err, ErrNamespaceNotLinked) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, s := range cost.Shortfalls {
+			p.fail(tx, ErrCoreInsufficientBalance, "%s misses %d of mosaic %s", sender.Address.Address, s.Amount, s.AssetId)
+		}
+	}
+
+	return nil
+}
+
+// checkNamespaceActive returns false when the namespace is unknown or expired
+func (p *preflight) checkNamespaceActive(ctx context.Context, tx Transaction, namespaceId *NamespaceId) (bool, error) {
+	info, err := p.namespace(ctx, namespaceId)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case info == nil:
+		p.fail(tx, ErrNamespaceAliasNamespaceUnknown, "namespace %s is unknown", namespaceId)
+		return false, nil
+	case p.namespaceExpired(info):
+		p.fail(tx, ErrNamespaceExpired, "namespace %s is expired", namespaceId)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// mosaicActive returns nil when the mosaic is unknown or expired
+func (p *preflight) mosaicActive(ctx context.Context, tx Transaction, mosaicId *MosaicId) (*MosaicInfo, error) {
+	info, err := p.mosaic(ctx, mosaicId)
+	if err != nil {
+		return nil, err
+	}
+
+	if info == nil {
+		p.fail(tx, ErrMosaicExpired, "mosaic %s is unknown", mosaicId)
+		return nil, nil
+	}
+
+	if info.Properties != nil && info.Properties.Duration() > 0 && p.height >= info.Height+Height(info.Properties.Duration()) {
+		p.fail(tx, ErrMosaicExpired, "mosaic %s is expired", mosaicId)
+		return nil, nil
+	}
+
+	return info, nil
+}
+
+func (p *preflight) namespaceExpired(info *NamespaceInfo) bool {
+	return info.EndHeight != eternalNamespaceHeight && p.height >= info.EndHeight
+}
+
+func (p *preflight) accountProperties(ctx context.Context, address *Address) (*AccountProperties, error) {
+	if properties, ok := p.properties[address.Address]; ok {
+		return properties, nil
+	}
+
+	properties, err := p.Client.Account.GetAccountProperties(ctx, address)
+	switch {
+	case IsNotFound(err):
+		properties = &AccountProperties{Address: address}
+	case err != nil:
+		return nil, err
+	}
+
+	p.properties[address.Address] = properties
+
+	return properties, nil
+}
+
+// mosaic returns nil for unknown mosaics
+func (p *preflight) mosaic(ctx context.Context, mosaicId *MosaicId) (*MosaicInfo, error) {
+	if info, ok := p.mosaics[*mosaicId]; ok {
+		return info, nil
+	}
+
+	info, err := p.Client.Mosaic.GetMosaicInfo(ctx, mosaicId)
+	switch {
+	case IsNotFound(err):
+		info = nil
+	case err != nil:
+		return nil, err
+	}
+
+	p.mosaics[*mosaicId] = info
+
+	return info, nil
+}
+
+// namespace returns nil for unknown namespaces
+func (p *preflight) namespace(ctx context.Context, namespaceId *NamespaceId) (*NamespaceInfo, error) {
+	if namespaceId == nil {
+		return nil, ErrNilNamespaceId
+	}
+
+	if info, ok := p.namespaces[*namespaceId]; ok {
+		return info, nil
+	}
+
+	info, err := p.Client.Namespace.GetNamespaceInfo(ctx, namespaceId)
+	switch {
+	case IsNotFound(err):
+		info = nil
+	case err != nil:
+		return nil, err
+	}
+
+	p.namespaces[*namespaceId] = info
+
+	return info, nil
+}
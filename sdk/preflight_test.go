@@ -0,0 +1,191 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePreflightAccounts struct {
+	AccountService
+	properties map[string]*AccountProperties
+	multisig   map[string]*MultisigAccountInfo
+	balances   map[string][]*Mosaic
+}
+
+func (s *fakePreflightAccounts) GetAccountProperties(_ context.Context, address *Address) (*AccountProperties, error) {
+	properties, ok := s.properties[address.Address]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return properties, nil
+}
+
+func (s *fakePreflightAccounts) GetMultisigAccountInfo(_ context.Context, address *Address) (*MultisigAccountInfo, error) {
+	info, ok := s.multisig[address.Address]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return info, nil
+}
+
+func (s *fakePreflightAccounts) GetAccountInfo(_ context.Context, address *Address) (*AccountInfo, error) {
+	return &AccountInfo{Address: address, Mosaics: s.balances[address.Address]}, nil
+}
+
+type fakePreflightMosaics struct {
+	MosaicService
+	infos map[MosaicId]*MosaicInfo
+}
+
+func (s *fakePreflightMosaics) GetMosaicInfo(_ context.Context, mosaicId *MosaicId) (*MosaicInfo, error) {
+	info, ok := s.infos[*mosaicId]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return info, nil
+}
+
+func (s *fakePreflightMosaics) GetMosaicLevy(context.Context, *MosaicId) (*MosaicLevy, error) {
+	return nil, ErrResourceNotFound
+}
+
+type fakePreflightNamespaces struct {
+	NamespaceService
+	infos   map[NamespaceId]*NamespaceInfo
+	mosaics map[NamespaceId]*MosaicId
+}
+
+func (s *fakePreflightNamespaces) GetNamespaceInfo(_ context.Context, namespaceId *NamespaceId) (*NamespaceInfo, error) {
+	info, ok := s.infos[*namespaceId]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return info, nil
+}
+
+func (s *fakePreflightNamespaces) GetLinkedMosaicId(_ context.Context, namespaceId *NamespaceId) (*MosaicId, error) {
+	return s.mosaics[*namespaceId], nil
+}
+
+func TestPreflightValidator_Validate(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	signer, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	issuer, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	recipient, err := NewAccountFromPublicKey("A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F90", PublicTest)
+	require.NoError(t, err)
+
+	xpx, err := NewMosaicId(0x0DC67FBE1CAD29E3)
+	require.NoError(t, err)
+	ticket, err := NewMosaicId(0x1111)
+	require.NoError(t, err)
+	token, err := NewNamespaceIdFromName("token")
+	require.NoError(t, err)
+
+	accounts := &fakePreflightAccounts{
+		properties: map[string]*AccountProperties{
+			signer.Address.Address:    {BlockedEntityTypes: []EntityType{MosaicSupplyChange}},
+			recipient.Address.Address: {BlockedMosaicId: []*MosaicId{ticket}},
+		},
+		multisig: make(map[string]*MultisigAccountInfo),
+		balances: map[string][]*Mosaic{
+			signer.Address.Address: {newMosaicPanic(xpx, 100), newMosaicPanic(ticket, 1)},
+		},
+	}
+
+	client := NewClient(nil, config)
+	client.Network = &fakeNetworkConfigService{config: "[chain]\n\nblockGenerationTargetTime = 15s\nmaxTransactionLifetime = 24h\n"}
+	client.Blockchain = &fakeChainHeight{height: 1000}
+	client.Account = accounts
+	client.Mosaic = &fakePreflightMosaics{infos: map[MosaicId]*MosaicInfo{
+		*xpx:    {MosaicId: xpx, Supply: 1000000, Owner: issuer.PublicAccount, Properties: NewMosaicProperties(false, true, 6, 0)},
+		*ticket: {MosaicId: ticket, Supply: 10, Owner: issuer.PublicAccount, Properties: NewMosaicProperties(true, false, 0, 0)},
+	}}
+	client.Namespace = &fakePreflightNamespaces{
+		infos: map[NamespaceId]*NamespaceInfo{
+			*token: {NamespaceId: token, Owner: signer.PublicAccount, EndHeight: 1000},
+		},
+		mosaics: map[NamespaceId]*MosaicId{*XpxNamespaceId: xpx},
+	}
+
+	validator := NewPreflightValidator(client)
+
+	transfer, err := client.NewTransferTransaction(NewDeadline(time.Hour), recipient.Address, []*Mosaic{newMosaicPanic(xpx, 60)}, NewPlainMessage(""))
+	require.NoError(t, err)
+	transfer.MaxFee = 10
+
+	report, err := validator.Validate(ctx, transfer, signer.PublicAccount)
+	require.NoError(t, err)
+	assert.True(t, report.Ok(), report.Findings)
+	assert.Nil(t, report.Err())
+
+	ticketTransfer, err := NewTransferTransaction(NewDeadline(time.Hour), recipient.Address, []*Mosaic{newMosaicPanic(ticket, 1)}, NewPlainMessage(""), PublicTest)
+	require.NoError(t, err)
+	ticketTransfer.ToAggregate(signer.PublicAccount)
+	transfer.ToAggregate(signer.PublicAccount)
+	alias, err := NewMosaicAliasTransaction(NewDeadline(time.Hour), ticket, token, AliasLink, PublicTest)
+	require.NoError(t, err)
+	alias.ToAggregate(signer.PublicAccount)
+	supply, err := NewMosaicSupplyChangeTransaction(NewDeadline(time.Hour), ticket, Increase, 1, PublicTest)
+	require.NoError(t, err)
+	supply.ToAggregate(signer.PublicAccount)
+
+	aggregate, err := NewCompleteAggregateTransaction(NewDeadline(time.Hour*48), []Transaction{transfer, ticketTransfer, alias, supply}, PublicTest)
+	require.NoError(t, err)
+	aggregate.MaxFee = 50
+
+	report, err = validator.Validate(ctx, aggregate, signer.PublicAccount)
+	require.NoError(t, err)
+
+	errs := make([]error, 0, len(report.Findings))
+	for _, f := range report.Findings {
+		errs = append(errs, f.Err)
+	}
+	assert.Equal(t, []error{
+		ErrCoreFutureDeadline,
+		ErrPropertyMosaicTransferNotAllowed,
+		ErrMosaicNonTransferable,
+		ErrNamespaceExpired,
+		ErrPropertyTransactionTypeNotAllowed,
+		ErrMosaicOwnerConflict,
+		ErrCoreInsufficientBalance,
+	}, errs)
+	assert.Equal(t, ticketTransfer, report.Findings[1].Transaction)
+	assert.Equal(t, ErrCoreFutureDeadline, report.Err())
+
+	// 60 transferred and 50 of the fee
+	assert.Contains(t, report.Findings[6].Message, "misses 10 of mosaic")
+
+	// bonded aggregates are allowed to live longer than maxTransactionLifetime
+	bondedTransfer, err := NewTransferTransaction(NewDeadline(time.Hour), recipient.Address, []*Mosaic{newMosaicPanic(xpx, 10)}, NewPlainMessage(""), PublicTest)
+	require.NoError(t, err)
+	bondedTransfer.ToAggregate(signer.PublicAccount)
+	bonded, err := NewBondedAggregateTransaction(NewDeadline(time.Hour*30), []Transaction{bondedTransfer}, PublicTest)
+	require.NoError(t, err)
+
+	report, err = validator.Validate(ctx, bonded, signer.PublicAccount)
+	require.NoError(t, err)
+	assert.True(t, report.Ok(), report.Findings)
+
+	accounts.multisig[signer.Address.Address] = &MultisigAccountInfo{Cosignatories: []*PublicAccount{issuer.PublicAccount}}
+	transfer.Deadline = NewDeadline(time.Hour)
+	report, err = validator.Validate(ctx, transfer, signer.PublicAccount)
+	require.NoError(t, err)
+	require.Len(t, report.Findings, 1)
+	assert.Equal(t, ErrMultisigOperationNotPermittedByAccount, report.Err())
+}
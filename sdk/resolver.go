@@ -9,14 +9,22 @@ import (
 	"errors"
 )
 
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name ResolverService --output ../mocks --outpkg mocks
+
+// ResolverService resolves AssetId into MosaicInfo through namespace and mosaic endpoints
+type ResolverService interface {
+	GetMosaicInfoByAssetId(ctx context.Context, assetId AssetId) (*MosaicInfo, error)
+	GetMosaicInfosByAssetIds(ctx context.Context, assetIds ...AssetId) ([]*MosaicInfo, error)
+}
+
 // TODO: Implement resolving namespace to account
-type ResolverService struct {
+type resolverService struct {
 	*service
-	NamespaceService *NamespaceService
-	MosaicService    *MosaicService
+	NamespaceService NamespaceService
+	MosaicService    MosaicService
 }
 
-func (ref *ResolverService) GetMosaicInfoByAssetId(ctx context.Context, assetId AssetId) (*MosaicInfo, error) {
+func (ref *resolverService) GetMosaicInfoByAssetId(ctx context.Context, assetId AssetId) (*MosaicInfo, error) {
 	if assetId == nil {
 		return nil, ErrNilAssetId
 	}
@@ -43,7 +51,7 @@ func (ref *ResolverService) GetMosaicInfoByAssetId(ctx context.Context, assetId
 	return nil, ErrUnknownBlockchainType
 }
 
-func (ref *ResolverService) GetMosaicInfosByAssetIds(ctx context.Context, assetIds ...AssetId) ([]*MosaicInfo, error) {
+func (ref *resolverService) GetMosaicInfosByAssetIds(ctx context.Context, assetIds ...AssetId) ([]*MosaicInfo, error) {
 	if len(assetIds) == 0 {
 		return nil, ErrEmptyAssetIds
 	}
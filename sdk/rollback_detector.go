@@ -0,0 +1,261 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultRollbackWindow is the default number of recent blocks kept by RollbackDetector.
+// It matches the default maxRollbackBlocks of the network.
+const DefaultRollbackWindow = 360
+
+// Rollback is emitted when the node dropped blocks above ToHeight up to FromHeight
+type Rollback struct {
+	FromHeight Height
+	ToHeight   Height
+	// TransactionHashes are tracked transactions which were confirmed in dropped blocks and should be checked again
+	TransactionHashes []*Hash
+}
+
+func (r *Rollback) String() string {
+	return fmt.Sprintf(`{ "FromHeight": %s, "ToHeight": %s, "TransactionHashes": %s }`, r.FromHeight, r.ToHeight, r.TransactionHashes)
+}
+
+type blockRef struct {
+	height Height
+	hash   *Hash
+}
+
+// RollbackDetector keeps hashes of recent blocks and notices when a new block does not continue them.
+// Blocks are usually taken from the block websocket subscription, confirmed transactions which should
+// be checked again after a rollback are passed to Track, e.g. from the confirmedAdded subscription.
+// The zero value is ready to use once Blockchain is set.
+type RollbackDetector struct {
+	Blockchain BlockchainService
+	// Window is DefaultRollbackWindow when it is not positive
+	Window int
+
+	m      sync.Mutex
+	blocks []blockRef
+	txs    map[Height][]*Hash
+}
+
+// returns RollbackDetector with DefaultRollbackWindow
+func NewRollbackDetector(blockchain BlockchainService) *RollbackDetector {
+	return &RollbackDetector{
+		Blockchain: blockchain,
+		Window:     DefaultRollbackWindow,
+		txs:        make(map[Height][]*Hash),
+	}
+}
+
+// Track remembers the confirmed transaction, so it is returned in Rollback if its block is dropped.
+// A transaction tracked again at the same height is ignored
+func (d *RollbackDetector) Track(tx Transaction) {
+	info := tx.GetAbstractTransaction().TransactionInfo
+	if info.Height == 0 || info.TransactionHash == nil {
+		return
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	if d.txs == nil {
+		d.txs = make(map[Height][]*Hash)
+	}
+
+	for _, hash := range d.txs[info.Height] {
+		if hash.Equal(info.TransactionHash) {
+			return
+		}
+	}
+
+	d.txs[info.Height] = append(d.txs[info.Height], info.TransactionHash)
+}
+
+// Observe adds the block to the window. It returns Rollback when the block does not continue kept blocks.
+// Missed blocks are requested from the node, so the window has no gaps.
+func (d *RollbackDetector) Observe(ctx context.Context, block *BlockInfo) (*Rollback, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	if len(d.blocks) == 0 {
+		d.push(block)
+		return nil, nil
+	}
+
+	last := d.blocks[len(d.blocks)-1]
+
+	if block.Height > last.height+1 {
+		for h := last.height + 1; h < block.Height; h++ {
+			missed, err := d.Blockchain.GetBlockByHeight(ctx, h)
+			if err != nil {
+				return nil, err
+			}
+
+			// the missed block is observed before the new one, a rollback in between is reported by them
+			if rollback, err := d.observe(ctx, missed); rollback != nil || err != nil {
+				if err == nil {
+					err = d.fill(ctx, block)
+				}
+				return rollback, err
+			}
+		}
+	}
+
+	return d.observe(ctx, block)
+}
+
+func (d *RollbackDetector) observe(ctx context.Context, block *BlockInfo) (*Rollback, error) {
+	last := d.blocks[len(d.blocks)-1]
+
+	if block.Height == last.height+1 && block.PreviousBlockHash.Equal(last.hash) {
+		d.push(block)
+		return nil, nil
+	}
+
+	if known := d.find(block.Height); known != nil && known.hash.Equal(block.BlockHash) {
+		// already observed
+		return nil, nil
+	}
+
+	common, err := d.commonHeight(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback := &Rollback{
+		FromHeight:        last.height,
+		ToHeight:          common,
+		TransactionHashes: make([]*Hash, 0),
+	}
+
+	for h := common + 1; h <= last.height; h++ {
+		rollback.TransactionHashes = append(rollback.TransactionHashes, d.txs[h]...)
+		delete(d.txs, h)
+	}
+
+	for len(d.blocks) > 0 && d.blocks[len(d.blocks)-1].height > common {
+		d.blocks = d.blocks[:len(d.blocks)-1]
+	}
+
+	return rollback, d.fill(ctx, block)
+}
+
+// commonHeight returns the height of the last kept block which is still in the chain of the block
+func (d *RollbackDetector) commonHeight(ctx context.Context, block *BlockInfo) (Height, error) {
+	if parent := d.find(block.Height - 1); parent != nil && parent.hash.Equal(block.PreviousBlockHash) {
+		return parent.height, nil
+	}
+
+	for i := len(d.blocks) - 1; i >= 0; i-- {
+		ref := d.blocks[i]
+		if ref.height >= block.Height {
+			continue
+		}
+
+		info, err := d.Blockchain.GetBlockByHeight(ctx, ref.height)
+		if err != nil {
+			return 0, err
+		}
+
+		if info.BlockHash.Equal(ref.hash) {
+			return ref.height, nil
+		}
+	}
+
+	// the rollback is deeper than the window, everything kept is dropped
+	if block.Height < d.blocks[0].height {
+		return block.Height - 1, nil
+	}
+
+	return d.blocks[0].height - 1, nil
+}
+
+// fill pushes blocks of the current chain after the last kept block up to the block
+func (d *RollbackDetector) fill(ctx context.Context, block *BlockInfo) error {
+	if len(d.blocks) == 0 {
+		d.push(block)
+		return nil
+	}
+
+	next := d.blocks[len(d.blocks)-1].height + 1
+
+	for h := next; h < block.Height; h++ {
+		info, err := d.Blockchain.GetBlockByHeight(ctx, h)
+		if err != nil {
+			return err
+		}
+
+		d.push(info)
+	}
+
+	if block.Height >= next {
+		d.push(block)
+	}
+
+	return nil
+}
+
+func (d *RollbackDetector) push(block *BlockInfo) {
+	d.blocks = append(d.blocks, blockRef{block.Height, block.BlockHash})
+
+	window := d.Window
+	if window <= 0 {
+		window = DefaultRollbackWindow
+	}
+
+	if len(d.blocks) > window {
+		d.blocks = d.blocks[len(d.blocks)-window:]
+	}
+
+	for h := range d.txs {
+		if h < d.blocks[0].height {
+			delete(d.txs, h)
+		}
+	}
+}
+
+func (d *RollbackDetector) find(height Height) *blockRef {
+	for i := len(d.blocks) - 1; i >= 0; i-- {
+		if d.blocks[i].height == height {
+			return &d.blocks[i]
+		}
+	}
+
+	return nil
+}
+
+// Run observes blocks until ctx is done or blocks are closed and sends detected rollbacks
+func (d *RollbackDetector) Run(ctx context.Context, blocks <-chan *BlockInfo, rollbacks chan<- *Rollback) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case block, ok := <-blocks:
+			if !ok {
+				return nil
+			}
+
+			rollback, err := d.Observe(ctx, block)
+			if err != nil {
+				return err
+			}
+
+			if rollback == nil {
+				continue
+			}
+
+			select {
+			case rollbacks <- rollback:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
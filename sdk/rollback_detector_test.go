@@ -0,0 +1,158 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeForkChain is a BlockchainService which serves blocks of the current fork
+type fakeForkChain struct {
+	BlockchainService
+	blocks []*BlockInfo
+}
+
+func (c *fakeForkChain) GetBlockByHeight(_ context.Context, height Height) (*BlockInfo, error) {
+	if height == 0 || int(height) > len(c.blocks) {
+		return nil, ErrResourceNotFound
+	}
+
+	return c.blocks[height-1], nil
+}
+
+// grow adds count blocks on top of the chain, fork makes hashes differ from other forks
+func (c *fakeForkChain) grow(count int, fork byte) []*BlockInfo {
+	added := make([]*BlockInfo, count)
+	for i := range added {
+		b := &BlockInfo{Height: Height(len(c.blocks) + 1), BlockHash: &Hash{}, PreviousBlockHash: &Hash{}}
+		b.BlockHash[0], b.BlockHash[1] = byte(b.Height), fork
+		if len(c.blocks) > 0 {
+			b.PreviousBlockHash = c.blocks[len(c.blocks)-1].BlockHash
+		}
+		c.blocks = append(c.blocks, b)
+		added[i] = b
+	}
+
+	return added
+}
+
+func (c *fakeForkChain) rollback(count int) {
+	c.blocks = c.blocks[:len(c.blocks)-count]
+}
+
+func observeAll(t *testing.T, d *RollbackDetector, blocks []*BlockInfo) []*Rollback {
+	rollbacks := make([]*Rollback, 0)
+	for _, b := range blocks {
+		rollback, err := d.Observe(ctx, b)
+		require.NoError(t, err)
+		if rollback != nil {
+			rollbacks = append(rollbacks, rollback)
+		}
+	}
+
+	return rollbacks
+}
+
+func trackedTx(height Height, hash byte) Transaction {
+	tx := &TransferTransaction{}
+	tx.Height = height
+	tx.TransactionHash = &Hash{hash}
+	return tx
+}
+
+func TestRollbackDetector_Observe(t *testing.T) {
+	chain := &fakeForkChain{}
+	d := NewRollbackDetector(chain)
+
+	assert.Empty(t, observeAll(t, d, chain.grow(10, 0)))
+	d.Track(trackedTx(8, 8))
+	d.Track(trackedTx(9, 9))
+	d.Track(trackedTx(10, 10))
+
+	chain.rollback(2)
+	rollbacks := observeAll(t, d, chain.grow(3, 1))
+
+	require.Len(t, rollbacks, 1)
+	assert.Equal(t, Height(10), rollbacks[0].FromHeight)
+	assert.Equal(t, Height(8), rollbacks[0].ToHeight)
+	assert.Equal(t, []*Hash{{9}, {10}}, rollbacks[0].TransactionHashes)
+
+	// the same blocks again are not a rollback
+	assert.Empty(t, observeAll(t, d, chain.blocks[8:]))
+}
+
+func TestRollbackDetector_ZeroValue(t *testing.T) {
+	chain := &fakeForkChain{}
+	d := &RollbackDetector{Blockchain: chain}
+
+	d.Track(trackedTx(3, 3))
+	d.Track(trackedTx(3, 3))
+	assert.Empty(t, observeAll(t, d, chain.grow(3, 0)))
+
+	chain.rollback(1)
+	rollbacks := observeAll(t, d, chain.grow(2, 1))
+
+	require.Len(t, rollbacks, 1)
+	assert.Equal(t, []*Hash{{3}}, rollbacks[0].TransactionHashes)
+}
+
+func TestRollbackDetector_MissedBlocks(t *testing.T) {
+	chain := &fakeForkChain{}
+	d := NewRollbackDetector(chain)
+
+	observeAll(t, d, chain.grow(5, 0))
+	d.Track(trackedTx(5, 5))
+
+	chain.rollback(1)
+	blocks := chain.grow(4, 1)
+
+	// only the last block is received, e.g. after a websocket reconnect
+	rollbacks := observeAll(t, d, blocks[3:])
+	require.Len(t, rollbacks, 1)
+	assert.Equal(t, Height(5), rollbacks[0].FromHeight)
+	assert.Equal(t, Height(4), rollbacks[0].ToHeight)
+	assert.Equal(t, []*Hash{{5}}, rollbacks[0].TransactionHashes)
+
+	assert.Empty(t, observeAll(t, d, chain.grow(1, 1)))
+}
+
+func TestRollbackDetector_DeeperThanWindow(t *testing.T) {
+	chain := &fakeForkChain{}
+	d := NewRollbackDetector(chain)
+	d.Window = 3
+
+	observeAll(t, d, chain.grow(10, 0))
+
+	chain.rollback(5)
+	rollbacks := observeAll(t, d, chain.grow(6, 1))
+
+	require.Len(t, rollbacks, 1)
+	assert.Equal(t, Height(10), rollbacks[0].FromHeight)
+	assert.Equal(t, Height(5), rollbacks[0].ToHeight)
+}
+
+func TestRollbackDetector_Run(t *testing.T) {
+	chain := &fakeForkChain{}
+	d := NewRollbackDetector(chain)
+
+	blocks := make(chan *BlockInfo, 10)
+	for _, b := range chain.grow(3, 0) {
+		blocks <- b
+	}
+	chain.rollback(1)
+	blocks <- chain.grow(1, 1)[0]
+	close(blocks)
+
+	rollbacks := make(chan *Rollback, 1)
+	require.NoError(t, d.Run(ctx, blocks, rollbacks))
+
+	rollback := <-rollbacks
+	assert.Equal(t, Height(3), rollback.FromHeight)
+	assert.Equal(t, Height(2), rollback.ToHeight)
+}
@@ -15,6 +15,9 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -34,6 +37,16 @@ type HttpError struct {
 	StatusCode int
 }
 
+// IsNotFound reports whether err means that the requested resource does not exist
+func IsNotFound(err error) bool {
+	if err == ErrResourceNotFound {
+		return true
+	}
+
+	e, ok := err.(*HttpError)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
 type FeeCalculationStrategy uint32
 
 // FeeCalculationStrategy enums
@@ -46,14 +59,53 @@ const (
 // Provides service configuration
 type Config struct {
 	reputationConfig      *reputationConfig
+	urlsLock              sync.RWMutex
 	BaseURLs              []url.URL
 	UsedBaseUrl           url.URL
 	WsReconnectionTimeout time.Duration
-	GenerationHash        *Hash
+	// MaxMessageSize limits messages of transfers built by Client, it includes the message type byte.
+	// NewConfig reads it from the network config. Zero means that only the message size field of the transaction limits them
+	MaxMessageSize int
+	GenerationHash *Hash
 	NetworkType
 	FeeCalculationStrategy
 }
 
+// GetBaseURLs returns a copy of BaseURLs which is safe to use while urls are added or removed
+func (c *Config) GetBaseURLs() []url.URL {
+	c.urlsLock.RLock()
+	defer c.urlsLock.RUnlock()
+
+	return append([]url.URL(nil), c.BaseURLs...)
+}
+
+// AddBaseURLs appends urls which are not in BaseURLs yet
+func (c *Config) AddBaseURLs(urls ...url.URL) {
+	c.urlsLock.Lock()
+	defer c.urlsLock.Unlock()
+
+	for _, u := range urls {
+		if !containsURL(c.BaseURLs, u) {
+			c.BaseURLs = append(c.BaseURLs, u)
+		}
+	}
+}
+
+// RemoveBaseURLs removes urls from BaseURLs
+func (c *Config) RemoveBaseURLs(urls ...url.URL) {
+	c.urlsLock.Lock()
+	defer c.urlsLock.Unlock()
+
+	baseUrls := make([]url.URL, 0, len(c.BaseURLs))
+	for _, u := range c.BaseURLs {
+		if !containsURL(urls, u) {
+			baseUrls = append(baseUrls, u)
+		}
+	}
+
+	c.BaseURLs = baseUrls
+}
+
 type reputationConfig struct {
 	minInteractions   uint64
 	defaultReputation float64
@@ -103,7 +155,7 @@ func NewConfig(ctx context.Context, baseUrls []string) (*Config, error) {
 		return nil, err
 	}
 
-	return NewConfigWithReputation(
+	config, err := NewConfigWithReputation(
 		baseUrls,
 		networkType,
 		&defaultRepConfig,
@@ -111,6 +163,16 @@ func NewConfig(ctx context.Context, baseUrls []string) (*Config, error) {
 		block.GenerationHash,
 		DefaultFeeCalculationStrategy,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// messages are limited only by the transaction format when nodes do not serve the network config
+	if maxMessageSize, err := tempClient.MaxMessageSize(ctx); err == nil {
+		config.MaxMessageSize = maxMessageSize
+	}
+
+	return config, nil
 }
 
 func NewConfigWithReputation(
@@ -153,25 +215,27 @@ type Client struct {
 	config *Config
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 	// Services for communicating to the Catapult REST APIs
-	Blockchain        *BlockchainService
-	Exchange          *ExchangeService
-	SdaExchange       *SdaExchangeService
-	Mosaic            *MosaicService
-	Namespace         *NamespaceService
-	Node              *NodeService
-	Network           *NetworkService
-	Transaction       *TransactionService
-	Resolve           *ResolverService
-	Account           *AccountService
-	Storage           *StorageService
-	StorageV2         *StorageV2Service
-	SuperContract     *SuperContractService
-	SuperContractV2   *SuperContractV2Service
-	Lock              *LockService
-	Contract          *ContractService
-	Metadata          *MetadataService
-	MetadataV2        *MetadataV2Service
-	LiquidityProvider *LiquidityProviderService
+	Blockchain        BlockchainService
+	Exchange          ExchangeService
+	SdaExchange       SdaExchangeService
+	Mosaic            MosaicService
+	Namespace         NamespaceService
+	Node              NodeService
+	Network           NetworkService
+	Transaction       TransactionService
+	Resolve           ResolverService
+	Account           AccountService
+	Storage           StorageService
+	StorageV2         StorageV2Service
+	SuperContract     SuperContractService
+	SuperContractV2   SuperContractV2Service
+	Lock              LockService
+	Contract          ContractService
+	Metadata          MetadataService
+	MetadataV2        MetadataV2Service
+	LiquidityProvider LiquidityProviderService
+	// TimeSync corrects deadlines of NewDeadline with the offset of the node time
+	TimeSync *TimeSync
 }
 
 type service struct {
@@ -200,25 +264,26 @@ func NewClient(httpClient *http.Client, conf *Config) *Client {
 
 	c := &Client{client: httpClient, config: conf}
 	c.common.client = c
-	c.Blockchain = (*BlockchainService)(&c.common)
-	c.Mosaic = (*MosaicService)(&c.common)
-	c.Namespace = (*NamespaceService)(&c.common)
-	c.Node = (*NodeService)(&c.common)
-	c.Network = &NetworkService{&c.common, c.Blockchain}
-	c.Resolve = &ResolverService{&c.common, c.Namespace, c.Mosaic}
-	c.Transaction = &TransactionService{&c.common, c.Blockchain}
-	c.Exchange = &ExchangeService{&c.common, c.Resolve}
-	c.SdaExchange = &SdaExchangeService{&c.common, c.Resolve}
-	c.Account = (*AccountService)(&c.common)
-	c.Lock = (*LockService)(&c.common)
-	c.Storage = &StorageService{&c.common, c.Lock}
-	c.StorageV2 = (*StorageV2Service)(&c.common)
-	c.SuperContract = (*SuperContractService)(&c.common)
-	c.SuperContractV2 = (*SuperContractV2Service)(&c.common)
-	c.Contract = (*ContractService)(&c.common)
-	c.Metadata = (*MetadataService)(&c.common)
-	c.MetadataV2 = (*MetadataV2Service)(&c.common)
-	c.LiquidityProvider = (*LiquidityProviderService)(&c.common)
+	c.Blockchain = (*blockchainService)(&c.common)
+	c.Mosaic = (*mosaicService)(&c.common)
+	c.Namespace = (*namespaceService)(&c.common)
+	c.Node = (*nodeService)(&c.common)
+	c.Network = &networkService{&c.common, c.Blockchain}
+	c.Resolve = &resolverService{&c.common, c.Namespace, c.Mosaic}
+	c.Transaction = &transactionService{&c.common, c.Blockchain}
+	c.Exchange = &exchangeService{&c.common, c.Resolve}
+	c.SdaExchange = &sdaExchangeService{&c.common, c.Resolve}
+	c.Account = (*accountService)(&c.common)
+	c.Lock = (*lockService)(&c.common)
+	c.Storage = &storageService{&c.common, c.Lock}
+	c.StorageV2 = (*storageV2Service)(&c.common)
+	c.SuperContract = (*superContractService)(&c.common)
+	c.SuperContractV2 = (*superContractV2Service)(&c.common)
+	c.Contract = (*contractService)(&c.common)
+	c.Metadata = (*metadataService)(&c.common)
+	c.MetadataV2 = (*metadataV2Service)(&c.common)
+	c.LiquidityProvider = (*liquidityProviderService)(&c.common)
+	c.TimeSync = NewTimeSync(c)
 
 	return c
 }
@@ -231,6 +296,18 @@ func (c *Client) GenerationHash() *Hash {
 	return c.config.GenerationHash
 }
 
+// NewDeadline returns a deadline of a transaction delta after the node time measured by TimeSync.
+// It returns ErrDeadlineOutOfRange when delta is not positive or exceeds the max transaction lifetime
+func (c *Client) NewDeadline(delta time.Duration) (*Deadline, error) {
+	return c.TimeSync.Deadline(delta)
+}
+
+// NewBondedDeadline returns a deadline of an AggregateBonded transaction delta after the node time measured by TimeSync.
+// It returns ErrDeadlineOutOfRange when delta is not positive or exceeds the max bonded transaction lifetime
+func (c *Client) NewBondedDeadline(delta time.Duration) (*Deadline, error) {
+	return c.TimeSync.BondedDeadline(delta)
+}
+
 // BlockGenerationTime gets value from config. If value not found returns default value - 15s
 func (c *Client) BlockGenerationTime(ctx context.Context) (time.Duration, error) {
 	cfg, err := c.Network.GetNetworkConfig(ctx)
@@ -247,6 +324,52 @@ func (c *Client) BlockGenerationTime(ctx context.Context) (time.Duration, error)
 	return time.Second * 15, nil
 }
 
+// MaxMessageSize gets maxMessageSize of transfers from config. If value not found returns DefaultMaxMessageSize
+func (c *Client) MaxMessageSize(ctx context.Context) (int, error) {
+	cfg, err := c.Network.GetNetworkConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if pl, ok := cfg.NetworkConfig.Sections["plugin:catapult.plugins.transfer"]; ok {
+		if v, ok := pl.Fields["maxMessageSize"]; ok {
+			return strconv.Atoi(strings.ReplaceAll(v.Value, "'", ""))
+		}
+	}
+
+	return DefaultMaxMessageSize, nil
+}
+
+// MaxTransactionLifetime gets the max lifetime of transactions of the entity type from config.
+// AggregateBonded transactions live for maxBondedTransactionLifetime of the aggregate plugin, others for maxTransactionLifetime
+func (c *Client) MaxTransactionLifetime(ctx context.Context, entityType EntityType) (time.Duration, error) {
+	cfg, err := c.Network.GetNetworkConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	lifetime, bondedLifetime, err := transactionLifetimes(cfg.NetworkConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	if entityType == AggregateBonded {
+		return bondedLifetime, nil
+	}
+
+	return lifetime, nil
+}
+
+// checkMessageSize returns ErrMessageTooLarge when the message does not fit into MaxMessageSize of the config
+func (c *Client) checkMessageSize(message Message) error {
+	maxSize := c.config.MaxMessageSize
+	if maxSize <= 0 {
+		maxSize = maxMessagePayloadSize
+	}
+
+	return checkMessageSize(message, maxSize)
+}
+
 // AdaptAccount returns a new account with the same network type and generation hash like a Client
 func (c *Client) AdaptAccount(account *Account) (*Account, error) {
 	return c.NewAccountFromPrivateKey(account.PrivateKey.String())
@@ -263,7 +386,7 @@ func (c *Client) doNewRequest(ctx context.Context, method string, path string, b
 	if err != nil {
 		switch err.(type) {
 		case *url.Error:
-			for _, url := range c.config.BaseURLs {
+			for _, url := range c.config.GetBaseURLs() {
 				if c.config.UsedBaseUrl == url {
 					continue
 				}
@@ -625,6 +748,10 @@ func (c *Client) NewMosaicSupplyChangeTransaction(deadline *Deadline, assetId As
 }
 
 func (c *Client) NewTransferTransaction(deadline *Deadline, recipient *Address, mosaics []*Mosaic, message Message) (*TransferTransaction, error) {
+	if err := c.checkMessageSize(message); err != nil {
+		return nil, err
+	}
+
 	tx, err := NewTransferTransaction(deadline, recipient, mosaics, message, c.config.NetworkType)
 	if tx != nil {
 		c.modifyTransaction(tx)
@@ -634,6 +761,10 @@ func (c *Client) NewTransferTransaction(deadline *Deadline, recipient *Address,
 }
 
 func (c *Client) NewTransferTransactionWithNamespace(deadline *Deadline, recipient *NamespaceId, mosaics []*Mosaic, message Message) (*TransferTransaction, error) {
+	if err := c.checkMessageSize(message); err != nil {
+		return nil, err
+	}
+
 	tx, err := NewTransferTransactionWithNamespace(deadline, recipient, mosaics, message, c.config.NetworkType)
 	if tx != nil {
 		c.modifyTransaction(tx)
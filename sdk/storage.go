@@ -13,12 +13,25 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type StorageService struct {
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name StorageService --output ../mocks --outpkg mocks
+
+// StorageService provides storage (drive) REST endpoints
+type StorageService interface {
+	GetDrive(ctx context.Context, driveKey *PublicAccount) (*Drive, error)
+	GetDrives(ctx context.Context, dpOpts *DrivesPageOptions) (*DrivesPage, error)
+	GetAccountDrives(ctx context.Context, driveKey *PublicAccount, filter DriveParticipantFilter) ([]*Drive, error)
+	GetVerificationStatus(ctx context.Context, driveKey *PublicAccount) (*VerificationStatus, error)
+	GetDownloadInfo(ctx context.Context, operationToken *Hash) (*DownloadInfo, error)
+	GetAccountDownloadInfos(ctx context.Context, recipient *PublicAccount) ([]*DownloadInfo, error)
+	GetDriveDownloadInfos(ctx context.Context, drive *PublicAccount) ([]*DownloadInfo, error)
+}
+
+type storageService struct {
 	*service
-	LockService *LockService
+	LockService LockService
 }
 
-func (s *StorageService) GetDrive(ctx context.Context, driveKey *PublicAccount) (*Drive, error) {
+func (s *storageService) GetDrive(ctx context.Context, driveKey *PublicAccount) (*Drive, error) {
 	if driveKey == nil {
 		return nil, ErrNilAddress
 	}
@@ -39,7 +52,7 @@ func (s *StorageService) GetDrive(ctx context.Context, driveKey *PublicAccount)
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageService) GetDrives(ctx context.Context, dpOpts *DrivesPageOptions) (*DrivesPage, error) {
+func (s *storageService) GetDrives(ctx context.Context, dpOpts *DrivesPageOptions) (*DrivesPage, error) {
 	dspDTO := &drivesPageDTO{}
 
 	u, err := addOptions(drivesRoute, dpOpts)
@@ -67,7 +80,7 @@ const (
 	ReplicatorDrive DriveParticipantFilter = "/replicator"
 )
 
-func (s *StorageService) GetAccountDrives(ctx context.Context, driveKey *PublicAccount, filter DriveParticipantFilter) ([]*Drive, error) {
+func (s *storageService) GetAccountDrives(ctx context.Context, driveKey *PublicAccount, filter DriveParticipantFilter) ([]*Drive, error) {
 	if driveKey == nil {
 		return nil, ErrNilAddress
 	}
@@ -90,7 +103,7 @@ func (s *StorageService) GetAccountDrives(ctx context.Context, driveKey *PublicA
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageService) GetVerificationStatus(ctx context.Context, driveKey *PublicAccount) (*VerificationStatus, error) {
+func (s *storageService) GetVerificationStatus(ctx context.Context, driveKey *PublicAccount) (*VerificationStatus, error) {
 	if driveKey == nil {
 		return nil, ErrNilAddress
 	}
@@ -127,7 +140,7 @@ func (s *StorageService) GetVerificationStatus(ctx context.Context, driveKey *Pu
 	}, nil
 }
 
-func (s *StorageService) GetDownloadInfo(ctx context.Context, operationToken *Hash) (*DownloadInfo, error) {
+func (s *storageService) GetDownloadInfo(ctx context.Context, operationToken *Hash) (*DownloadInfo, error) {
 	if operationToken == nil {
 		return nil, ErrNilHash
 	}
@@ -148,7 +161,7 @@ func (s *StorageService) GetDownloadInfo(ctx context.Context, operationToken *Ha
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageService) GetAccountDownloadInfos(ctx context.Context, recipient *PublicAccount) ([]*DownloadInfo, error) {
+func (s *storageService) GetAccountDownloadInfos(ctx context.Context, recipient *PublicAccount) ([]*DownloadInfo, error) {
 	if recipient == nil {
 		return nil, ErrNilAccount
 	}
@@ -169,7 +182,7 @@ func (s *StorageService) GetAccountDownloadInfos(ctx context.Context, recipient
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageService) GetDriveDownloadInfos(ctx context.Context, drive *PublicAccount) ([]*DownloadInfo, error) {
+func (s *storageService) GetDriveDownloadInfos(ctx context.Context, drive *PublicAccount) ([]*DownloadInfo, error) {
 	if drive == nil {
 		return nil, ErrNilAccount
 	}
@@ -12,9 +12,21 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type StorageV2Service service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name StorageV2Service --output ../mocks --outpkg mocks
+
+// StorageV2Service provides storage v2 (drive, replicator and download channel) REST endpoints
+type StorageV2Service interface {
+	GetDrive(ctx context.Context, driveKey *PublicAccount) (*BcDrive, error)
+	GetDrives(ctx context.Context, bdpOpts *BcDrivesPageOptions) (*BcDrivesPage, error)
+	GetReplicator(ctx context.Context, replicatorKey *PublicAccount) (*Replicator, error)
+	GetReplicators(ctx context.Context, rpOpts *ReplicatorsPageOptions) (*ReplicatorsPage, error)
+	GetDownloadChannelInfo(ctx context.Context, downloadChannelId *Hash) (*DownloadChannel, error)
+	GetDownloadChannels(ctx context.Context, rpOpts *DownloadChannelsPageOptions) (*DownloadChannelsPage, error)
+}
+
+type storageV2Service service
 
-func (s *StorageV2Service) GetDrive(ctx context.Context, driveKey *PublicAccount) (*BcDrive, error) {
+func (s *storageV2Service) GetDrive(ctx context.Context, driveKey *PublicAccount) (*BcDrive, error) {
 	if driveKey == nil {
 		return nil, ErrNilAddress
 	}
@@ -35,7 +47,7 @@ func (s *StorageV2Service) GetDrive(ctx context.Context, driveKey *PublicAccount
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageV2Service) GetDrives(ctx context.Context, bdpOpts *BcDrivesPageOptions) (*BcDrivesPage, error) {
+func (s *storageV2Service) GetDrives(ctx context.Context, bdpOpts *BcDrivesPageOptions) (*BcDrivesPage, error) {
 	bcdspDTO := &bcDrivesPageDTO{}
 
 	u, err := addOptions(drivesRouteV2, bdpOpts)
@@ -55,7 +67,7 @@ func (s *StorageV2Service) GetDrives(ctx context.Context, bdpOpts *BcDrivesPageO
 	return bcdspDTO.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageV2Service) GetReplicator(ctx context.Context, replicatorKey *PublicAccount) (*Replicator, error) {
+func (s *storageV2Service) GetReplicator(ctx context.Context, replicatorKey *PublicAccount) (*Replicator, error) {
 	if replicatorKey == nil {
 		return nil, ErrNilAddress
 	}
@@ -76,7 +88,7 @@ func (s *StorageV2Service) GetReplicator(ctx context.Context, replicatorKey *Pub
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageV2Service) GetReplicators(ctx context.Context, rpOpts *ReplicatorsPageOptions) (*ReplicatorsPage, error) {
+func (s *storageV2Service) GetReplicators(ctx context.Context, rpOpts *ReplicatorsPageOptions) (*ReplicatorsPage, error) {
 	rspDTO := &replicatorsPageDTO{}
 
 	u, err := addOptions(replicatorsRouteV2, rpOpts)
@@ -96,7 +108,7 @@ func (s *StorageV2Service) GetReplicators(ctx context.Context, rpOpts *Replicato
 	return rspDTO.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageV2Service) GetDownloadChannelInfo(ctx context.Context, downloadChannelId *Hash) (*DownloadChannel, error) {
+func (s *storageV2Service) GetDownloadChannelInfo(ctx context.Context, downloadChannelId *Hash) (*DownloadChannel, error) {
 	if downloadChannelId == nil {
 		return nil, ErrNilAddress
 	}
@@ -117,7 +129,7 @@ func (s *StorageV2Service) GetDownloadChannelInfo(ctx context.Context, downloadC
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *StorageV2Service) GetDownloadChannels(ctx context.Context, rpOpts *DownloadChannelsPageOptions) (*DownloadChannelsPage, error) {
+func (s *storageV2Service) GetDownloadChannels(ctx context.Context, rpOpts *DownloadChannelsPageOptions) (*DownloadChannelsPage, error) {
 	dcspDTO := &downloadChannelsPageDTO{}
 
 	u, err := addOptions(downloadChannelsRouteV2, rpOpts)
@@ -11,9 +11,19 @@ import (
 	"net/http"
 )
 
-type SuperContractService service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name SuperContractService --output ../mocks --outpkg mocks
+
+// SuperContractService provides super contract REST endpoints
+type SuperContractService interface {
+	GetSuperContract(ctx context.Context, contractKey *PublicAccount) (*SuperContract, error)
+	GetDriveSuperContracts(ctx context.Context, driveKey *PublicAccount) ([]*SuperContract, error)
+	GetOperation(ctx context.Context, operationHash *Hash) (*Operation, error)
+	GetOperationsByAccount(ctx context.Context, account *PublicAccount) ([]*Operation, error)
+}
+
+type superContractService service
 
-func (s *SuperContractService) GetSuperContract(ctx context.Context, contractKey *PublicAccount) (*SuperContract, error) {
+func (s *superContractService) GetSuperContract(ctx context.Context, contractKey *PublicAccount) (*SuperContract, error) {
 	if contractKey == nil {
 		return nil, ErrNilAddress
 	}
@@ -34,7 +44,7 @@ func (s *SuperContractService) GetSuperContract(ctx context.Context, contractKey
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *SuperContractService) GetDriveSuperContracts(ctx context.Context, driveKey *PublicAccount) ([]*SuperContract, error) {
+func (s *superContractService) GetDriveSuperContracts(ctx context.Context, driveKey *PublicAccount) ([]*SuperContract, error) {
 	if driveKey == nil {
 		return nil, ErrNilAddress
 	}
@@ -55,7 +65,7 @@ func (s *SuperContractService) GetDriveSuperContracts(ctx context.Context, drive
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *SuperContractService) GetOperation(ctx context.Context, operationHash *Hash) (*Operation, error) {
+func (s *superContractService) GetOperation(ctx context.Context, operationHash *Hash) (*Operation, error) {
 	if operationHash == nil {
 		return nil, ErrNilHash
 	}
@@ -76,7 +86,7 @@ func (s *SuperContractService) GetOperation(ctx context.Context, operationHash *
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *SuperContractService) GetOperationsByAccount(ctx context.Context, account *PublicAccount) ([]*Operation, error) {
+func (s *superContractService) GetOperationsByAccount(ctx context.Context, account *PublicAccount) ([]*Operation, error) {
 	if account == nil {
 		return nil, ErrNilAddress
 	}
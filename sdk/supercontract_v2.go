@@ -12,9 +12,17 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
-type SuperContractV2Service service
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name SuperContractV2Service --output ../mocks --outpkg mocks
 
-func (s *SuperContractV2Service) GetSuperContractV2(ctx context.Context, superContractKey *PublicAccount) (*SuperContractV2, error) {
+// SuperContractV2Service provides super contract v2 REST endpoints
+type SuperContractV2Service interface {
+	GetSuperContractV2(ctx context.Context, superContractKey *PublicAccount) (*SuperContractV2, error)
+	GetSuperContractsV2(ctx context.Context, scPageOpts *SuperContractsV2PageOptions) (*SuperContractsV2Page, error)
+}
+
+type superContractV2Service service
+
+func (s *superContractV2Service) GetSuperContractV2(ctx context.Context, superContractKey *PublicAccount) (*SuperContractV2, error) {
 	if superContractKey == nil {
 		return nil, ErrNilAddress
 	}
@@ -35,7 +43,7 @@ func (s *SuperContractV2Service) GetSuperContractV2(ctx context.Context, superCo
 	return dto.toStruct(s.client.NetworkType())
 }
 
-func (s *SuperContractV2Service) GetSuperContractsV2(ctx context.Context, scPageOpts *SuperContractsV2PageOptions) (*SuperContractsV2Page, error) {
+func (s *superContractV2Service) GetSuperContractsV2(ctx context.Context, scPageOpts *SuperContractsV2PageOptions) (*SuperContractsV2Page, error) {
 	scPageDTO := &superContractV2PageDTO{}
 
 	u, err := addOptions(superContractsRouteV2, scPageOpts)
@@ -0,0 +1,320 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultTimeSyncSamples  = 3
+	DefaultTimeSyncInterval = time.Minute * 10
+	DefaultTimeSyncTimeout  = time.Second * 10
+	DefaultMaxClockSkew     = time.Second * 30
+
+	DefaultMaxTransactionLifetime = time.Hour * 24
+	// DefaultMaxBondedTransactionLifetime is the max lifetime of AggregateBonded transactions
+	DefaultMaxBondedTransactionLifetime = time.Hour * 48
+)
+
+// TimeMeasurement is the offset of the node time against the local clock
+type TimeMeasurement struct {
+	// Offset is the node time minus the local time
+	Offset    time.Duration
+	RoundTrip time.Duration
+	// Time is the local time of the measurement
+	Time time.Time
+	// Skewed is true when the offset exceeds MaxSkew of TimeSync
+	Skewed bool
+}
+
+// TimeSyncReport is sent by TimeSync.Monitor after every synchronization
+type TimeSyncReport struct {
+	Measurement *TimeMeasurement
+	Err         error
+}
+
+// TimeSync measures the offset of the node time, so deadlines are built relative to the clock of the node.
+// Deadline syncs when the last measurement is older than Interval. Offset is zero until the first Sync
+// and a TimeSync without Client keeps the local clock
+type TimeSync struct {
+	Client *Client
+	// Samples are requests of the node time per Sync, the one with the shortest round trip is used
+	Samples int
+	// MaxSkew is DefaultMaxClockSkew when it is not set
+	MaxSkew time.Duration
+	// Interval is DefaultTimeSyncInterval when it is not set
+	Interval time.Duration
+
+	m                 sync.RWMutex
+	syncing           sync.Mutex
+	last              *TimeMeasurement
+	maxDeadline       time.Duration
+	maxBondedDeadline time.Duration
+	now               func() time.Time
+}
+
+// returns TimeSync with default samples, interval and max skew
+func NewTimeSync(client *Client) *TimeSync {
+	return &TimeSync{
+		Client:   client,
+		Samples:  DefaultTimeSyncSamples,
+		MaxSkew:  DefaultMaxClockSkew,
+		Interval: DefaultTimeSyncInterval,
+	}
+}
+
+// Sync measures the offset of the node time and refreshes max deadlines from config of the network.
+// The node time is taken at the middle of the round trip
+func (s *TimeSync) Sync(ctx context.Context) (*TimeMeasurement, error) {
+	var best *TimeMeasurement
+	for i := 0; i < s.Samples || best == nil; i++ {
+		sent := s.clock()
+		timestamp, err := s.Client.Node.GetNodeTime(ctx)
+		received := s.clock()
+		if err != nil {
+			return nil, err
+		}
+
+		if timestamp == nil {
+			return nil, ErrNodeTimeUnavailable
+		}
+
+		roundTrip := received.Sub(sent)
+		if best != nil && roundTrip >= best.RoundTrip {
+			continue
+		}
+
+		best = &TimeMeasurement{
+			Offset:    timestamp.ToTimestamp().Sub(sent.Add(roundTrip / 2)),
+			RoundTrip: roundTrip,
+			Time:      received,
+		}
+	}
+
+	skew := best.Offset
+	if skew < 0 {
+		skew = -skew
+	}
+	best.Skewed = skew > s.maxSkew()
+
+	cfg, err := s.Client.Network.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDeadline, maxBondedDeadline, err := transactionLifetimes(cfg.NetworkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.last = best
+	s.maxDeadline = maxDeadline
+	s.maxBondedDeadline = maxBondedDeadline
+
+	return best, nil
+}
+
+// Monitor calls Sync every Interval and sends its reports. Reports of skewed clocks have Measurement.Skewed set
+func (s *TimeSync) Monitor(ctx context.Context) <-chan *TimeSyncReport {
+	reports := make(chan *TimeSyncReport)
+
+	go func() {
+		defer close(reports)
+
+		ticker := time.NewTicker(s.interval())
+		defer ticker.Stop()
+
+		for {
+			measurement, err := s.Sync(ctx)
+
+			select {
+			case reports <- &TimeSyncReport{Measurement: measurement, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return reports
+}
+
+// Last returns the last measurement or nil before the first Sync
+func (s *TimeSync) Last() *TimeMeasurement {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.last
+}
+
+// Offset returns the offset of the last measurement
+func (s *TimeSync) Offset() time.Duration {
+	if last := s.Last(); last != nil {
+		return last.Offset
+	}
+
+	return 0
+}
+
+// Now returns the local time corrected with the offset of the node time
+func (s *TimeSync) Now() time.Time {
+	return s.clock().Add(s.Offset())
+}
+
+// MaxDeadline returns the max lifetime of transactions from the last Sync or its default,
+// see Client.MaxTransactionLifetime
+func (s *TimeSync) MaxDeadline() time.Duration {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.maxDeadline == 0 {
+		return DefaultMaxTransactionLifetime
+	}
+
+	return s.maxDeadline
+}
+
+// MaxBondedDeadline returns the max lifetime of AggregateBonded transactions from the last Sync or its default
+func (s *TimeSync) MaxBondedDeadline() time.Duration {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.maxBondedDeadline == 0 {
+		return DefaultMaxBondedTransactionLifetime
+	}
+
+	return s.maxBondedDeadline
+}
+
+// Deadline returns a deadline of a transaction delta after the node time.
+// It returns ErrDeadlineOutOfRange when delta is not positive or exceeds MaxDeadline
+func (s *TimeSync) Deadline(delta time.Duration) (*Deadline, error) {
+	if err := s.syncIfStale(); err != nil {
+		return nil, err
+	}
+
+	return s.deadline(delta, s.MaxDeadline())
+}
+
+// BondedDeadline returns a deadline of an AggregateBonded transaction delta after the node time.
+// It returns ErrDeadlineOutOfRange when delta is not positive or exceeds MaxBondedDeadline
+func (s *TimeSync) BondedDeadline(delta time.Duration) (*Deadline, error) {
+	if err := s.syncIfStale(); err != nil {
+		return nil, err
+	}
+
+	return s.deadline(delta, s.MaxBondedDeadline())
+}
+
+func (s *TimeSync) deadline(delta, max time.Duration) (*Deadline, error) {
+	if delta <= 0 || delta > max {
+		return nil, ErrDeadlineOutOfRange
+	}
+
+	return &Deadline{Timestamp{s.Now().Add(delta)}}, nil
+}
+
+// syncIfStale syncs when there is no measurement yet or it is older than Interval
+func (s *TimeSync) syncIfStale() error {
+	if s.Client == nil || !s.stale() {
+		return nil
+	}
+
+	s.syncing.Lock()
+	defer s.syncing.Unlock()
+
+	if !s.stale() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeSyncTimeout)
+	defer cancel()
+
+	_, err := s.Sync(ctx)
+
+	return err
+}
+
+func (s *TimeSync) stale() bool {
+	last := s.Last()
+
+	return last == nil || s.clock().Sub(last.Time) >= s.interval()
+}
+
+func (s *TimeSync) clock() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+
+	return s.now()
+}
+
+func (s *TimeSync) interval() time.Duration {
+	if s.Interval <= 0 {
+		return DefaultTimeSyncInterval
+	}
+
+	return s.Interval
+}
+
+func (s *TimeSync) maxSkew() time.Duration {
+	if s.MaxSkew <= 0 {
+		return DefaultMaxClockSkew
+	}
+
+	return s.MaxSkew
+}
+
+// transactionLifetimes returns max lifetimes of transactions and bonded aggregates from config.
+// Values which are not found are DefaultMaxTransactionLifetime and DefaultMaxBondedTransactionLifetime
+func transactionLifetimes(config *NetworkConfig) (lifetime, bondedLifetime time.Duration, err error) {
+	lifetime, bondedLifetime = DefaultMaxTransactionLifetime, DefaultMaxBondedTransactionLifetime
+
+	if pl, ok := config.Sections["chain"]; ok {
+		if v, ok := pl.Fields["maxTransactionLifetime"]; ok {
+			if lifetime, err = parseConfigDuration(v.Value); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+
+	if pl, ok := config.Sections["plugin:catapult.plugins.aggregate"]; ok {
+		if v, ok := pl.Fields["maxBondedTransactionLifetime"]; ok {
+			if bondedLifetime, err = parseConfigDuration(v.Value); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+
+	return lifetime, bondedLifetime, nil
+}
+
+// parseConfigDuration parses durations of config values, e.g. 3650d, 15s or 500ms
+func parseConfigDuration(v string) (time.Duration, error) {
+	v = configNumber(v)
+	if strings.HasSuffix(v, "d") {
+		days, err := strconv.ParseInt(strings.TrimSuffix(v, "d"), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(days) * time.Hour * 24, nil
+	}
+
+	return time.ParseDuration(v)
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNodeTime answers with the clock shifted by offset at the middle of every round trip
+type fakeNodeTime struct {
+	NodeService
+	clock      time.Time
+	offset     time.Duration
+	roundTrips []time.Duration
+	calls      int
+}
+
+func (s *fakeNodeTime) GetNodeTime(context.Context) (*BlockchainTimestamp, error) {
+	if len(s.roundTrips) == 0 {
+		return nil, nil
+	}
+
+	roundTrip := s.roundTrips[s.calls%len(s.roundTrips)]
+	s.calls++
+
+	s.clock = s.clock.Add(roundTrip / 2)
+	timestamp := (&Timestamp{s.clock.Add(s.offset)}).ToBlockchainTimestamp()
+	s.clock = s.clock.Add(roundTrip / 2)
+
+	return timestamp, nil
+}
+
+// markSynced lets deadlines of the client use the local clock without requesting the node time
+func markSynced(client *Client) {
+	client.TimeSync.last = &TimeMeasurement{Time: time.Now()}
+}
+
+func TestTimeSync_Sync(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	node := &fakeNodeTime{
+		clock:      time.UnixMilli(1700000000000),
+		offset:     -time.Minute,
+		roundTrips: []time.Duration{time.Millisecond * 300, time.Millisecond * 100, time.Millisecond * 200},
+	}
+
+	client := NewClient(nil, config)
+	client.Node = node
+	client.Network = &fakeNetworkConfigService{config: "[chain]\n\nmaxTransactionLifetime = 6h\n\n" +
+		"[plugin:catapult.plugins.aggregate]\n\nmaxBondedTransactionLifetime = 12h\n"}
+	client.TimeSync.now = func() time.Time { return node.clock }
+
+	// deadlines are not corrected before the first sync
+	assert.Nil(t, client.TimeSync.Last())
+	assert.Equal(t, DefaultMaxTransactionLifetime, client.TimeSync.MaxDeadline())
+	assert.Equal(t, DefaultMaxBondedTransactionLifetime, client.TimeSync.MaxBondedDeadline())
+
+	measurement, err := client.TimeSync.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, node.calls)
+	assert.Equal(t, -time.Minute, measurement.Offset)
+	assert.Equal(t, time.Millisecond*100, measurement.RoundTrip)
+	assert.True(t, measurement.Skewed)
+	assert.Equal(t, time.Hour*6, client.TimeSync.MaxDeadline())
+	assert.Equal(t, time.Hour*12, client.TimeSync.MaxBondedDeadline())
+
+	deadline, err := client.NewDeadline(time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, node.clock.Add(time.Hour-time.Minute), deadline.Time)
+
+	for _, delta := range []time.Duration{0, -time.Second, time.Hour*6 + time.Second} {
+		_, err = client.NewDeadline(delta)
+		assert.Equal(t, ErrDeadlineOutOfRange, err, delta)
+	}
+
+	// bonded aggregates live longer than other transactions
+	_, err = client.NewBondedDeadline(time.Hour * 12)
+	require.NoError(t, err)
+	_, err = client.NewBondedDeadline(time.Hour*12 + time.Second)
+	assert.Equal(t, ErrDeadlineOutOfRange, err)
+	_, err = client.NewDeadline(time.Hour * 12)
+	assert.Equal(t, ErrDeadlineOutOfRange, err)
+
+	node.offset = time.Second
+	measurement, err = client.TimeSync.Sync(ctx)
+	require.NoError(t, err)
+	assert.False(t, measurement.Skewed)
+	assert.Equal(t, time.Second, client.TimeSync.Offset())
+
+	node.roundTrips = nil
+	_, err = client.TimeSync.Sync(ctx)
+	assert.Equal(t, ErrNodeTimeUnavailable, err)
+}
+
+func TestTimeSync_LazySync(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	node := &fakeNodeTime{
+		clock:      time.UnixMilli(1700000000000),
+		offset:     time.Second * 5,
+		roundTrips: []time.Duration{time.Millisecond * 100},
+	}
+
+	client := NewClient(nil, config)
+	client.Node = node
+	client.Network = &fakeNetworkConfigService{config: "[chain]\n\nmaxTransactionLifetime = 6h\n"}
+	client.TimeSync.now = func() time.Time { return node.clock }
+
+	// the first deadline syncs
+	deadline, err := client.NewDeadline(time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTimeSyncSamples, node.calls)
+	assert.Equal(t, node.clock.Add(time.Hour+time.Second*5), deadline.Time)
+	assert.Equal(t, time.Hour*6, client.TimeSync.MaxDeadline())
+
+	_, err = client.NewDeadline(time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTimeSyncSamples, node.calls)
+
+	// stale measurements are refreshed
+	node.clock = node.clock.Add(DefaultTimeSyncInterval)
+	_, err = client.NewBondedDeadline(time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTimeSyncSamples*2, node.calls)
+
+	node.clock = node.clock.Add(DefaultTimeSyncInterval)
+	node.roundTrips = nil
+	_, err = client.NewDeadline(time.Hour)
+	assert.Equal(t, ErrNodeTimeUnavailable, err)
+}
+
+func TestTimeSync_ZeroValue(t *testing.T) {
+	var s TimeSync
+
+	assert.Equal(t, time.Duration(0), s.Offset())
+	assert.WithinDuration(t, time.Now(), s.Now(), time.Second)
+	assert.Equal(t, DefaultMaxTransactionLifetime, s.MaxDeadline())
+	assert.Equal(t, DefaultMaxBondedTransactionLifetime, s.MaxBondedDeadline())
+
+	deadline, err := s.Deadline(time.Hour)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), deadline.Time, time.Second)
+
+	_, err = s.BondedDeadline(DefaultMaxBondedTransactionLifetime + time.Second)
+	assert.Equal(t, ErrDeadlineOutOfRange, err)
+}
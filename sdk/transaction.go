@@ -13,13 +13,43 @@ import (
 	"github.com/pkg/errors"
 )
 
-type TransactionService struct {
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name TransactionService --output ../mocks --outpkg mocks
+
+// TransactionService provides transaction REST endpoints: announcing, statuses and transactions by group
+type TransactionService interface {
+	// GetTransaction returns Transaction for passed transaction id or hash
+	GetTransaction(ctx context.Context, group TransactionGroup, id string) (Transaction, error)
+	// GetAnyTransaction returns Transaction for passed transaction id or hash
+	GetAnyTransaction(ctx context.Context, id string) (Transaction, error)
+	// GetTransactions returns an array of Transaction's for passed array of transaction ids or hashes with any group
+	GetTransactions(ctx context.Context, ids []string) ([]Transaction, error)
+	// GetTransactionsByGroup returns an array of Transaction's for passed array of transaction ids or hashes
+	GetTransactionsByGroup(ctx context.Context, group TransactionGroup, tpOpts *TransactionsPageOptions) (*TransactionsPage, error)
+	// GetTransactionsByIds returns an array of Transaction's for passed array of transaction ids or hashes
+	GetTransactionsByIds(ctx context.Context, group TransactionGroup, ids []string, tpOpts *TransactionsPageOptions) ([]Transaction, error)
+	// Announce returns transaction hash after announcing passed SignedTransaction
+	Announce(ctx context.Context, tx *SignedTransaction) (string, error)
+	// AnnounceAggregateBonded returns transaction hash after announcing passed aggregate bounded SignedTransaction
+	AnnounceAggregateBonded(ctx context.Context, tx *SignedTransaction) (string, error)
+	// AnnounceAggregateBondedCosignature returns transaction hash after announcing passed CosignatureSignedTransaction
+	AnnounceAggregateBondedCosignature(ctx context.Context, c *CosignatureSignedTransaction) (string, error)
+	// GetTransactionStatus returns TransactionStatus for passed transaction id or hash
+	GetTransactionStatus(ctx context.Context, id string) (*TransactionStatus, error)
+	// GetTransactionsStatuses returns TransactionsStatuses for passed transactions id or hashes
+	GetTransactionsStatuses(ctx context.Context, hashes []string) ([]*TransactionStatus, error)
+	// GetTransactionEffectiveFee gets a transaction's effective paid fee
+	GetTransactionEffectiveFee(ctx context.Context, transactionId string) (int, error)
+	// WaitForFinality waits until the transaction is confirmed and final by the policy
+	WaitForFinality(ctx context.Context, hash *Hash, policy *FinalityPolicy) (*Finality, error)
+}
+
+type transactionService struct {
 	*service
-	BlockchainService *BlockchainService
+	BlockchainService BlockchainService
 }
 
 // GetTransaction returns Transaction for passed transaction id or hash
-func (txs *TransactionService) GetTransaction(ctx context.Context, group TransactionGroup, id string) (Transaction, error) {
+func (txs *transactionService) GetTransaction(ctx context.Context, group TransactionGroup, id string) (Transaction, error) {
 	var b bytes.Buffer
 
 	resp, err := txs.client.doNewRequest(ctx, http.MethodGet, fmt.Sprintf(transactionsByIdRoute, group, id), nil, &b)
@@ -35,7 +65,7 @@ func (txs *TransactionService) GetTransaction(ctx context.Context, group Transac
 }
 
 // GetAnyTransaction returns Transaction for passed transaction id or hash
-func (txs *TransactionService) GetAnyTransaction(ctx context.Context, id string) (Transaction, error) {
+func (txs *transactionService) GetAnyTransaction(ctx context.Context, id string) (Transaction, error) {
 	trS, err := txs.GetTransactionStatus(ctx, id)
 	if err != nil {
 		return nil, err
@@ -45,7 +75,7 @@ func (txs *TransactionService) GetAnyTransaction(ctx context.Context, id string)
 }
 
 // GetTransactions returns an array of Transaction's for passed array of transaction ids or hashes with any group
-func (txs *TransactionService) GetTransactions(ctx context.Context, ids []string) ([]Transaction, error) {
+func (txs *transactionService) GetTransactions(ctx context.Context, ids []string) ([]Transaction, error) {
 	txsStatuses, err := txs.GetTransactionsStatuses(ctx, ids)
 	if err != nil {
 		return nil, err
@@ -63,7 +93,7 @@ func (txs *TransactionService) GetTransactions(ctx context.Context, ids []string
 }
 
 // GetTransactionsByGroup returns an array of Transaction's for passed array of transaction ids or hashes
-func (txs *TransactionService) GetTransactionsByGroup(ctx context.Context, group TransactionGroup, tpOpts *TransactionsPageOptions) (*TransactionsPage, error) {
+func (txs *transactionService) GetTransactionsByGroup(ctx context.Context, group TransactionGroup, tpOpts *TransactionsPageOptions) (*TransactionsPage, error) {
 	tspDTO := &transactionsPageDTO{}
 
 	u, err := addOptions(fmt.Sprintf(transactionsByGroupRoute, group), tpOpts)
@@ -84,7 +114,7 @@ func (txs *TransactionService) GetTransactionsByGroup(ctx context.Context, group
 }
 
 // GetTransactionsByIds returns an array of Transaction's for passed array of transaction ids or hashes
-func (txs *TransactionService) GetTransactionsByIds(ctx context.Context, group TransactionGroup, ids []string, tpOpts *TransactionsPageOptions) ([]Transaction, error) {
+func (txs *transactionService) GetTransactionsByIds(ctx context.Context, group TransactionGroup, ids []string, tpOpts *TransactionsPageOptions) ([]Transaction, error) {
 	var b bytes.Buffer
 	txIds := &TransactionIdsDTO{
 		ids,
@@ -108,7 +138,7 @@ func (txs *TransactionService) GetTransactionsByIds(ctx context.Context, group T
 }
 
 // Announce returns transaction hash after announcing passed SignedTransaction
-func (txs *TransactionService) Announce(ctx context.Context, tx *SignedTransaction) (string, error) {
+func (txs *transactionService) Announce(ctx context.Context, tx *SignedTransaction) (string, error) {
 	dto := signedTransactionDto{
 		tx.EntityType,
 		tx.Payload,
@@ -124,7 +154,7 @@ func (txs *TransactionService) Announce(ctx context.Context, tx *SignedTransacti
 }
 
 // AnnounceAggregateBonded returns transaction hash after announcing passed aggregate bounded SignedTransaction
-func (txs *TransactionService) AnnounceAggregateBonded(ctx context.Context, tx *SignedTransaction) (string, error) {
+func (txs *transactionService) AnnounceAggregateBonded(ctx context.Context, tx *SignedTransaction) (string, error) {
 	dto := signedTransactionDto{
 		tx.EntityType,
 		tx.Payload,
@@ -140,7 +170,7 @@ func (txs *TransactionService) AnnounceAggregateBonded(ctx context.Context, tx *
 }
 
 // AnnounceAggregateBondedCosignature returns transaction hash after announcing passed CosignatureSignedTransaction
-func (txs *TransactionService) AnnounceAggregateBondedCosignature(ctx context.Context, c *CosignatureSignedTransaction) (string, error) {
+func (txs *transactionService) AnnounceAggregateBondedCosignature(ctx context.Context, c *CosignatureSignedTransaction) (string, error) {
 	dto := cosignatureSignedTransactionDto{
 		c.ParentHash.String(),
 		c.Signature.String(),
@@ -156,7 +186,7 @@ func (txs *TransactionService) AnnounceAggregateBondedCosignature(ctx context.Co
 }
 
 // GetTransactionStatus returns TransactionStatus for passed transaction id or hash
-func (txs *TransactionService) GetTransactionStatus(ctx context.Context, id string) (*TransactionStatus, error) {
+func (txs *transactionService) GetTransactionStatus(ctx context.Context, id string) (*TransactionStatus, error) {
 	ts := &transactionStatusDTO{}
 
 	resp, err := txs.client.doNewRequest(ctx, http.MethodGet, fmt.Sprintf(transactionStatusByIdRoute, id), nil, &ts)
@@ -172,7 +202,7 @@ func (txs *TransactionService) GetTransactionStatus(ctx context.Context, id stri
 }
 
 // GetTransactionsStatuses returns TransactionsStatuses for passed transactions id or hashes
-func (txs *TransactionService) GetTransactionsStatuses(ctx context.Context, hashes []string) ([]*TransactionStatus, error) {
+func (txs *transactionService) GetTransactionsStatuses(ctx context.Context, hashes []string) ([]*TransactionStatus, error) {
 	txIds := &TransactionHashesDTO{
 		hashes,
 	}
@@ -190,7 +220,7 @@ func (txs *TransactionService) GetTransactionsStatuses(ctx context.Context, hash
 	return dtos.toStruct()
 }
 
-func (txs *TransactionService) announceTransaction(ctx context.Context, tx interface{}, path string) (string, error) {
+func (txs *transactionService) announceTransaction(ctx context.Context, tx interface{}, path string) (string, error) {
 	m := struct {
 		Message string `json:"message"`
 	}{}
@@ -208,7 +238,7 @@ func (txs *TransactionService) announceTransaction(ctx context.Context, tx inter
 }
 
 // GetTransactionEffectiveFee gets a transaction's effective paid fee
-func (txs *TransactionService) GetTransactionEffectiveFee(ctx context.Context, transactionId string) (int, error) {
+func (txs *transactionService) GetTransactionEffectiveFee(ctx context.Context, transactionId string) (int, error) {
 	tx, err := txs.GetTransaction(ctx, Confirmed, transactionId)
 	if err != nil {
 		return -1, err
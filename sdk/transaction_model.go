@@ -1793,6 +1793,9 @@ func NewTransferTransaction(deadline *Deadline, recipient *Address, mosaics []*M
 	if message == nil {
 		return nil, errors.New("message must not be nil, but could be with empty payload")
 	}
+	if err := checkMessageSize(message, maxMessagePayloadSize); err != nil {
+		return nil, err
+	}
 
 	return &TransferTransaction{
 		AbstractTransaction: AbstractTransaction{
@@ -1818,6 +1821,9 @@ func NewTransferTransactionWithNamespace(deadline *Deadline, recipient *Namespac
 	if message == nil {
 		return nil, errors.New("message must not be nil, but could be with empty payload")
 	}
+	if err := checkMessageSize(message, maxMessagePayloadSize); err != nil {
+		return nil, err
+	}
 
 	address, err := NewAddressFromNamespace(recipient)
 	if err != nil {
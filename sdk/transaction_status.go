@@ -0,0 +1,215 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const statusSuccess = "Success"
+
+// StatusClass tells how a failed transaction status should be handled
+type StatusClass uint8
+
+const (
+	StatusClassUnknown StatusClass = iota
+	// StatusRetryable marks statuses after which the same transaction may succeed later
+	StatusRetryable
+	// StatusUserFixable marks statuses which can be fixed by changing the transaction or the account state
+	StatusUserFixable
+	// StatusPermanent marks statuses which will not change on retry
+	StatusPermanent
+)
+
+func (c StatusClass) String() string {
+	switch c {
+	case StatusRetryable:
+		return "Retryable"
+	case StatusUserFixable:
+		return "UserFixable"
+	case StatusPermanent:
+		return "Permanent"
+	default:
+		return "Unknown"
+	}
+}
+
+// StatusError is a transaction status returned by the node, e.g. "Failure_Core_Insufficient_Balance"
+type StatusError struct {
+	Status      string
+	Severity    string
+	Facility    string
+	Description string
+	Class       StatusClass
+}
+
+func (e *StatusError) Error() string {
+	return e.Status
+}
+
+// Is reports whether target is a StatusError with the same status
+func (e *StatusError) Is(target error) bool {
+	t, ok := target.(*StatusError)
+	return ok && t.Status == e.Status
+}
+
+var statusErrors = make(map[string]*StatusError)
+
+var statusFacilities = []string{
+	"LiquidityProvider",
+	"SuperContract",
+	"LockSecret",
+	"LockHash",
+}
+
+var retryableStatuses = map[string]bool{
+	"Failure_Core_Future_Deadline":  true,
+	"Failure_LockHash_Unknown_Hash": true,
+}
+
+var permanentWords = []string{
+	"Exists", "Already", "Conflict", "Expired", "Inactive", "Not_Permitted",
+	"Not_Allowed", "Not_Verifiable", "Nemesis", "Collision", "Immutable", "Disallowed",
+	"Non_Transferable", "Reserved", "Irreversible",
+}
+
+func newStatusError(status string) *StatusError {
+	e := parseStatus(status)
+	statusErrors[status] = e
+	return e
+}
+
+func parseStatus(status string) *StatusError {
+	e := &StatusError{Status: status}
+
+	parts := strings.SplitN(status, "_", 2)
+	e.Severity = parts[0]
+	if len(parts) > 1 {
+		e.Facility, e.Description = splitFacility(parts[1])
+	}
+
+	e.Class = classifyStatus(e)
+	return e
+}
+
+func splitFacility(s string) (string, string) {
+	for _, f := range statusFacilities {
+		if strings.HasPrefix(s, f+"_") {
+			return f, s[len(f)+1:]
+		}
+	}
+
+	parts := strings.SplitN(s, "_", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+func classifyStatus(e *StatusError) StatusClass {
+	if retryableStatuses[e.Status] {
+		return StatusRetryable
+	}
+
+	if e.Severity == "Neutral" {
+		return StatusPermanent
+	}
+
+	switch e.Facility {
+	case "Chain", "Consumer", "Extension":
+		return StatusRetryable
+	}
+
+	for _, w := range permanentWords {
+		if strings.Contains(e.Description, w) {
+			return StatusPermanent
+		}
+	}
+
+	if e.Severity == "Failure" {
+		return StatusUserFixable
+	}
+
+	return StatusClassUnknown
+}
+
+// NewStatusError returns the error for the given transaction status or nil for "Success".
+// Statuses missing from the catalogue are parsed and classified on the fly
+func NewStatusError(status string) error {
+	if status == "" || status == statusSuccess {
+		return nil
+	}
+
+	if e, ok := statusErrors[status]; ok {
+		return e
+	}
+
+	return parseStatus(status)
+}
+
+// IsRetryable reports whether err is a transaction status after which the transaction may succeed later
+func IsRetryable(err error) bool {
+	return statusClass(err) == StatusRetryable
+}
+
+// IsUserFixable reports whether err is a transaction status which can be fixed by the user
+func IsUserFixable(err error) bool {
+	return statusClass(err) == StatusUserFixable
+}
+
+// IsPermanent reports whether err is a transaction status which will not change on retry
+func IsPermanent(err error) bool {
+	return statusClass(err) == StatusPermanent
+}
+
+func statusClass(err error) StatusClass {
+	var e *StatusError
+	if errors.As(err, &e) {
+		return e.Class
+	}
+
+	return StatusClassUnknown
+}
+
+// Err returns the status as an error or nil if the transaction succeeded
+func (ts *TransactionStatus) Err() error {
+	return NewStatusError(ts.Status)
+}
+
+// Err returns the status as an error or nil if the transaction succeeded
+func (s *StatusInfo) Err() error {
+	return NewStatusError(s.Status)
+}
+
+// waitForConfirmation polls the status of the transaction until it is confirmed or failed
+func waitForConfirmation(ctx context.Context, client *Client, hash *Hash, pollInterval time.Duration) error {
+	for {
+		status, err := client.Transaction.GetTransactionStatus(ctx, hash.String())
+		if err != nil && !IsNotFound(err) {
+			return err
+		}
+
+		if status != nil {
+			if status.Group == Confirmed {
+				return nil
+			}
+
+			if err := status.Err(); err != nil {
+				return fmt.Errorf("transaction %s is failed: %w", hash, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+// The catalogue is maintained by hand and covers the core, aggregate, lock, mosaic, namespace, multisig,
+// property, transfer, metadata, exchange, liquidity provider, storage and super contract validators.
+// Statuses of other plugins, e.g. storage v2, super contract v2, metadata v2, sda exchange, operation,
+// upgrade, network config, committee, lock fund and streaming, are parsed by NewStatusError and classified
+// by their severity and description.
+
+// Core transaction status errors
+var (
+	// Validation failed because the deadline passed.
+	ErrCorePastDeadline = newStatusError("Failure_Core_Past_Deadline")
+	// Validation failed because the deadline is too far in the future.
+	ErrCoreFutureDeadline = newStatusError("Failure_Core_Future_Deadline")
+	// Validation failed because the account has an insufficient balance.
+	ErrCoreInsufficientBalance = newStatusError("Failure_Core_Insufficient_Balance")
+	// Validation failed because there are too many transactions in a block.
+	ErrCoreTooManyTransactions = newStatusError("Failure_Core_Too_Many_Transactions")
+	// Validation failed because an entity originated from the nemesis account after the nemesis block.
+	ErrCoreNemesisAccountSignedAfterNemesisBlock = newStatusError("Failure_Core_Nemesis_Account_Signed_After_Nemesis_Block")
+	// Validation failed because the entity has the wrong network specified.
+	ErrCoreWrongNetwork = newStatusError("Failure_Core_Wrong_Network")
+	// Validation failed because an address is invalid.
+	ErrCoreInvalidAddress = newStatusError("Failure_Core_Invalid_Address")
+	// Validation failed because the entity version is invalid.
+	ErrCoreInvalidVersion = newStatusError("Failure_Core_Invalid_Version")
+	// Validation failed because the transaction fee is invalid.
+	ErrCoreInvalidTransactionFee = newStatusError("Failure_Core_Invalid_Transaction_Fee")
+	// Validation failed because a block was harvested by an ineligible harvester.
+	ErrCoreBlockHarvesterIneligible = newStatusError("Failure_Core_Block_Harvester_Ineligible")
+	// Validation failed because an address is zero.
+	ErrCoreZeroAddress = newStatusError("Failure_Core_Zero_Address")
+	// Validation failed because a public key is zero.
+	ErrCoreZeroPublicKey = newStatusError("Failure_Core_Zero_Public_Key")
+	// Validation failed because internal padding is nonzero.
+	ErrCoreNonzeroInternalPadding = newStatusError("Failure_Core_Nonzero_Internal_Padding")
+	// Validation failed because an address collision is detected.
+	ErrCoreAddressCollision = newStatusError("Failure_Core_Address_Collision")
+	// Validation failed because the link action is invalid.
+	ErrCoreInvalidLinkAction = newStatusError("Failure_Core_Invalid_Link_Action")
+	// Validation failed because the main account is already linked to another account.
+	ErrCoreLinkAlreadyExists = newStatusError("Failure_Core_Link_Already_Exists")
+	// Validation failed because the main account is not linked to another account.
+	ErrCoreLinkDoesNotExist = newStatusError("Failure_Core_Link_Does_Not_Exist")
+	// Validation failed because unlink data is not consistent with the existing account link.
+	ErrCoreInconsistentUnlinkData = newStatusError("Failure_Core_Inconsistent_Unlink_Data")
+)
+
+// Aggregate transaction status errors
+var (
+	// Validation failed because aggregate has too many transactions.
+	ErrAggregateTooManyTransactions = newStatusError("Failure_Aggregate_Too_Many_Transactions")
+	// Validation failed because aggregate does not have any transactions.
+	ErrAggregateNoTransactions = newStatusError("Failure_Aggregate_No_Transactions")
+	// Validation failed because aggregate has too many cosignatures.
+	ErrAggregateTooManyCosignatures = newStatusError("Failure_Aggregate_Too_Many_Cosignatures")
+	// Validation failed because redundant cosignatures are present.
+	ErrAggregateRedundantCosignatures = newStatusError("Failure_Aggregate_Redundant_Cosignatures")
+	// Validation failed because at least one cosignatory is ineligible.
+	ErrAggregateIneligibleCosigners = newStatusError("Failure_Aggregate_Ineligible_Cosigners")
+	// Validation failed because at least one required cosignatory is missing.
+	ErrAggregateMissingCosigners = newStatusError("Failure_Aggregate_Missing_Cosigners")
+	// Validation failed because aggregate bonded transactions are not enabled.
+	ErrAggregateBondedNotEnabled = newStatusError("Failure_Aggregate_Bonded_Not_Enabled")
+)
+
+// Chain transaction status errors
+var (
+	// Validation failed because the block is unlinked from the chain.
+	ErrChainUnlinked = newStatusError("Failure_Chain_Unlinked")
+	// Validation failed because the block was not hit.
+	ErrChainBlockNotHit = newStatusError("Failure_Chain_Block_Not_Hit")
+	// Validation failed because the block has an inconsistent state hash.
+	ErrChainBlockInconsistentStateHash = newStatusError("Failure_Chain_Block_Inconsistent_State_Hash")
+)
+
+// Consumer transaction status errors
+var (
+	// Validation failed because the consumer input is empty.
+	ErrConsumerEmptyInput = newStatusError("Failure_Consumer_Empty_Input")
+	// Validation failed because the block transactions hash does not match the calculated value.
+	ErrConsumerBlockTransactionsHashMismatch = newStatusError("Failure_Consumer_Block_Transactions_Hash_Mismatch")
+	// Validation failed because the remote chain has too many blocks.
+	ErrConsumerRemoteChainTooManyBlocks = newStatusError("Failure_Consumer_Remote_Chain_Too_Many_Blocks")
+	// Validation failed because the remote chain is improperly linked.
+	ErrConsumerRemoteChainImproperLink = newStatusError("Failure_Consumer_Remote_Chain_Improper_Link")
+)
+
+// Exchange transaction status errors
+var (
+	// Validation failed because the offer type is invalid.
+	ErrExchangeInvalidOfferType = newStatusError("Failure_Exchange_Invalid_Offer_Type")
+	// Validation failed because the offer already exists.
+	ErrExchangeOfferExists = newStatusError("Failure_Exchange_Offer_Exists")
+	// Validation failed because the account has no offers.
+	ErrExchangeAccountDoesntExist = newStatusError("Failure_Exchange_Account_Doesnt_Exist")
+	// Validation failed because the offer does not exist.
+	ErrExchangeOfferDoesntExist = newStatusError("Failure_Exchange_Offer_Doesnt_Exist")
+	// Validation failed because the offer is expired.
+	ErrExchangeOfferExpired = newStatusError("Failure_Exchange_Offer_Expired")
+	// Validation failed because the offered amount is zero.
+	ErrExchangeZeroAmount = newStatusError("Failure_Exchange_Zero_Amount")
+	// Validation failed because the offered price is zero.
+	ErrExchangeZeroPrice = newStatusError("Failure_Exchange_Zero_Price")
+	// Validation failed because the offer does not have enough units.
+	ErrExchangeNotEnoughUnitsInOffer = newStatusError("Failure_Exchange_Not_Enough_Units_In_Offer")
+	// Validation failed because buying own units is not allowed.
+	ErrExchangeBuyingOwnUnitsIsNotAllowed = newStatusError("Failure_Exchange_Buying_Own_Units_Is_Not_Allowed")
+	// Validation failed because the mosaic is not allowed for exchange.
+	ErrExchangeMosaicNotAllowed = newStatusError("Failure_Exchange_Mosaic_Not_Allowed")
+)
+
+// Extension transaction status errors
+var (
+	// Validation failed because the partial transaction was pruned from the cache.
+	ErrExtensionPartialTransactionCachePrune = newStatusError("Failure_Extension_Partial_Transaction_Cache_Prune")
+	// Validation failed because the partial transaction was removed because its dependency was removed.
+	ErrExtensionPartialTransactionDependencyRemoved = newStatusError("Failure_Extension_Partial_Transaction_Dependency_Removed")
+	// Validation failed because the socket read rate limit was exceeded.
+	ErrExtensionReadRateLimitExceeded = newStatusError("Failure_Extension_Read_Rate_Limit_Exceeded")
+)
+
+// Hash transaction status errors
+var (
+	// Validation failed because the entity hash is already known.
+	ErrHashExists = newStatusError("Neutral_Hash_Exists")
+)
+
+// LiquidityProvider transaction status errors
+var (
+	// Validation failed because the liquidity provider already exists.
+	ErrLiquidityProviderLiquidityProviderAlreadyExists = newStatusError("Failure_LiquidityProvider_Liquidity_Provider_Already_Exists")
+	// Validation failed because the liquidity provider does not exist.
+	ErrLiquidityProviderLiquidityProviderDoesNotExist = newStatusError("Failure_LiquidityProvider_Liquidity_Provider_Does_Not_Exist")
+	// Validation failed because the slashing period is not over yet.
+	ErrLiquidityProviderSlashingPeriodIsNotOver = newStatusError("Failure_LiquidityProvider_Slashing_Period_Is_Not_Over")
+	// Validation failed because the exchange rate is invalid.
+	ErrLiquidityProviderInvalidExchangeRate = newStatusError("Failure_LiquidityProvider_Invalid_Exchange_Rate")
+	// Validation failed because the currency deposit is insufficient.
+	ErrLiquidityProviderInsufficientCurrencyDeposit = newStatusError("Failure_LiquidityProvider_Insufficient_Currency_Deposit")
+)
+
+// LockHash transaction status errors
+var (
+	// Validation failed because lock does not allow the specified mosaic.
+	ErrLockHashInvalidMosaicId = newStatusError("Failure_LockHash_Invalid_Mosaic_Id")
+	// Validation failed because lock does not allow the specified amount.
+	ErrLockHashInvalidMosaicAmount = newStatusError("Failure_LockHash_Invalid_Mosaic_Amount")
+	// Validation failed because hash is already present in the cache.
+	ErrLockHashHashExists = newStatusError("Failure_LockHash_Hash_Exists")
+	// Validation failed because hash is not present in the cache.
+	ErrLockHashUnknownHash = newStatusError("Failure_LockHash_Unknown_Hash")
+	// Validation failed because hash is inactive.
+	ErrLockHashInactiveHash = newStatusError("Failure_LockHash_Inactive_Hash")
+	// Validation failed because duration is too long.
+	ErrLockHashInvalidDuration = newStatusError("Failure_LockHash_Invalid_Duration")
+)
+
+// LockSecret transaction status errors
+var (
+	// Validation failed because hash algorithm for lock type secret is invalid.
+	ErrLockSecretInvalidHashAlgorithm = newStatusError("Failure_LockSecret_Invalid_Hash_Algorithm")
+	// Validation failed because hash used in lock secret is already present in the cache.
+	ErrLockSecretHashExists = newStatusError("Failure_LockSecret_Hash_Exists")
+	// Validation failed because proof is too small or too large.
+	ErrLockSecretProofSizeOutOfBounds = newStatusError("Failure_LockSecret_Proof_Size_Out_Of_Bounds")
+	// Validation failed because secret does not match the proof.
+	ErrLockSecretSecretMismatch = newStatusError("Failure_LockSecret_Secret_Mismatch")
+	// Validation failed because composite key is unknown.
+	ErrLockSecretUnknownCompositeKey = newStatusError("Failure_LockSecret_Unknown_Composite_Key")
+	// Validation failed because secret is inactive.
+	ErrLockSecretInactiveSecret = newStatusError("Failure_LockSecret_Inactive_Secret")
+	// Validation failed because hash algorithm does not match.
+	ErrLockSecretHashAlgorithmMismatch = newStatusError("Failure_LockSecret_Hash_Algorithm_Mismatch")
+	// Validation failed because duration is too long.
+	ErrLockSecretInvalidDuration = newStatusError("Failure_LockSecret_Invalid_Duration")
+)
+
+// Metadata transaction status errors
+var (
+	// Validation failed because the metadata value is too small.
+	ErrMetadataValueTooSmall = newStatusError("Failure_Metadata_Value_Too_Small")
+	// Validation failed because the metadata value is too large.
+	ErrMetadataValueTooLarge = newStatusError("Failure_Metadata_Value_Too_Large")
+	// Validation failed because the metadata value size delta is larger in magnitude than the value size.
+	ErrMetadataValueSizeDeltaTooLarge = newStatusError("Failure_Metadata_Value_Size_Delta_Too_Large")
+	// Validation failed because the metadata value size delta does not match the expected value.
+	ErrMetadataValueSizeDeltaMismatch = newStatusError("Failure_Metadata_Value_Size_Delta_Mismatch")
+	// Validation failed because the metadata value change (truncation) is irreversible.
+	ErrMetadataValueChangeIrreversible = newStatusError("Failure_Metadata_Value_Change_Irreversible")
+)
+
+// Mosaic transaction status errors
+var (
+	// Validation failed because mosaic duration is invalid.
+	ErrMosaicInvalidDuration = newStatusError("Failure_Mosaic_Invalid_Duration")
+	// Validation failed because mosaic name is invalid.
+	ErrMosaicInvalidName = newStatusError("Failure_Mosaic_Invalid_Name")
+	// Validation failed because mosaic name and id do not match.
+	ErrMosaicNameIdMismatch = newStatusError("Failure_Mosaic_Name_Id_Mismatch")
+	// Validation failed because the parent is expired.
+	ErrMosaicExpired = newStatusError("Failure_Mosaic_Expired")
+	// Validation failed because the parent owner conflicts with the child owner.
+	ErrMosaicOwnerConflict = newStatusError("Failure_Mosaic_Owner_Conflict")
+	// Validation failed because the id is not the expected id generated from signer and nonce.
+	ErrMosaicIdMismatch = newStatusError("Failure_Mosaic_Id_Mismatch")
+	// Validation failed because the existing parent id does not match the supplied parent id.
+	ErrMosaicParentIdConflict = newStatusError("Failure_Mosaic_Parent_Id_Conflict")
+	// Validation failed because a mosaic property is invalid.
+	ErrMosaicInvalidProperty = newStatusError("Failure_Mosaic_Invalid_Property")
+	// Validation failed because mosaic flags are invalid.
+	ErrMosaicInvalidFlags = newStatusError("Failure_Mosaic_Invalid_Flags")
+	// Validation failed because mosaic divisibility is invalid.
+	ErrMosaicInvalidDivisibility = newStatusError("Failure_Mosaic_Invalid_Divisibility")
+	// Validation failed because mosaic supply change direction is invalid.
+	ErrMosaicInvalidSupplyChangeDirection = newStatusError("Failure_Mosaic_Invalid_Supply_Change_Direction")
+	// Validation failed because mosaic supply change amount is invalid.
+	ErrMosaicInvalidSupplyChangeAmount = newStatusError("Failure_Mosaic_Invalid_Supply_Change_Amount")
+	// Validation failed because mosaic id is invalid.
+	ErrMosaicInvalidId = newStatusError("Failure_Mosaic_Invalid_Id")
+	// Validation failed because mosaic modification is not allowed.
+	ErrMosaicModificationDisallowed = newStatusError("Failure_Mosaic_Modification_Disallowed")
+	// Validation failed because mosaic modification would not result in any changes.
+	ErrMosaicModificationNoChanges = newStatusError("Failure_Mosaic_Modification_No_Changes")
+	// Validation failed because mosaic supply is immutable.
+	ErrMosaicSupplyImmutable = newStatusError("Failure_Mosaic_Supply_Immutable")
+	// Validation failed because resulting mosaic supply is negative.
+	ErrMosaicSupplyNegative = newStatusError("Failure_Mosaic_Supply_Negative")
+	// Validation failed because resulting mosaic supply exceeds the maximum allowed value.
+	ErrMosaicSupplyExceeded = newStatusError("Failure_Mosaic_Supply_Exceeded")
+	// Validation failed because the mosaic is not transferable.
+	ErrMosaicNonTransferable = newStatusError("Failure_Mosaic_Non_Transferable")
+	// Validation failed because the credit of the mosaic would exceed the maximum of different mosaics an account is allowed to own.
+	ErrMosaicMaxMosaicsExceeded = newStatusError("Failure_Mosaic_Max_Mosaics_Exceeded")
+	// Validation failed because mosaic levy type is invalid.
+	ErrMosaicInvalidLevyType = newStatusError("Failure_Mosaic_Invalid_Levy_Type")
+	// Validation failed because mosaic levy is not found.
+	ErrMosaicLevyNotFound = newStatusError("Failure_Mosaic_Levy_Not_Found")
+)
+
+// Multisig transaction status errors
+var (
+	// Validation failed because the account is present in both insert and delete sets.
+	ErrMultisigModifyAccountInBothSets = newStatusError("Failure_Multisig_Modify_Account_In_Both_Sets")
+	// Validation failed because more than one cosignatory is removed.
+	ErrMultisigModifyMultipleDeletes = newStatusError("Failure_Multisig_Modify_Multiple_Deletes")
+	// Validation failed because a cosignatory is inserted or removed more than once.
+	ErrMultisigModifyRedundantModifications = newStatusError("Failure_Multisig_Modify_Redundant_Modifications")
+	// Validation failed because the multisig account is unknown.
+	ErrMultisigModifyUnknownMultisigAccount = newStatusError("Failure_Multisig_Modify_Unknown_Multisig_Account")
+	// Validation failed because a cosignatory to remove is not present.
+	ErrMultisigModifyNotACosigner = newStatusError("Failure_Multisig_Modify_Not_A_Cosigner")
+	// Validation failed because a cosignatory to add is already present.
+	ErrMultisigModifyAlreadyACosigner = newStatusError("Failure_Multisig_Modify_Already_A_Cosigner")
+	// Validation failed because the minimum approval or removal setting is out of range.
+	ErrMultisigModifyMinSettingOutOfRange = newStatusError("Failure_Multisig_Modify_Min_Setting_Out_Of_Range")
+	// Validation failed because the minimum setting is larger than the number of cosignatories.
+	ErrMultisigModifyMinSettingLargerThanNumCosignatories = newStatusError("Failure_Multisig_Modify_Min_Setting_Larger_Than_Num_Cosignatories")
+	// Validation failed because the modification type is unsupported.
+	ErrMultisigModifyUnsupportedModificationType = newStatusError("Failure_Multisig_Modify_Unsupported_Modification_Type")
+	// Validation failed because the cosignatory already cosigns the maximum number of accounts.
+	ErrMultisigModifyMaxCosignedAccounts = newStatusError("Failure_Multisig_Modify_Max_Cosigned_Accounts")
+	// Validation failed because the multisig account already has the maximum number of cosignatories.
+	ErrMultisigModifyMaxCosigners = newStatusError("Failure_Multisig_Modify_Max_Cosigners")
+	// Validation failed because a multisig loop is created.
+	ErrMultisigModifyLoop = newStatusError("Failure_Multisig_Modify_Loop")
+	// Validation failed because the maximum multisig depth is exceeded.
+	ErrMultisigModifyMaxMultisigDepth = newStatusError("Failure_Multisig_Modify_Max_Multisig_Depth")
+	// Validation failed because an operation is not permitted by a multisig account.
+	ErrMultisigOperationNotPermittedByAccount = newStatusError("Failure_Multisig_Operation_Not_Permitted_By_Account")
+)
+
+// Namespace transaction status errors
+var (
+	// Validation failed because namespace duration is invalid.
+	ErrNamespaceInvalidDuration = newStatusError("Failure_Namespace_Invalid_Duration")
+	// Validation failed because namespace name is invalid.
+	ErrNamespaceInvalidName = newStatusError("Failure_Namespace_Invalid_Name")
+	// Validation failed because namespace name and id do not match.
+	ErrNamespaceNameIdMismatch = newStatusError("Failure_Namespace_Name_Id_Mismatch")
+	// Validation failed because namespace is expired.
+	ErrNamespaceExpired = newStatusError("Failure_Namespace_Expired")
+	// Validation failed because namespace owner conflicts with the transaction signer.
+	ErrNamespaceOwnerConflict = newStatusError("Failure_Namespace_Owner_Conflict")
+	// Validation failed because the id is not the expected id generated from name and parent id.
+	ErrNamespaceIdMismatch = newStatusError("Failure_Namespace_Id_Mismatch")
+	// Validation failed because namespace type is invalid.
+	ErrNamespaceInvalidNamespaceType = newStatusError("Failure_Namespace_Invalid_Namespace_Type")
+	// Validation failed because root namespace name is reserved.
+	ErrNamespaceRootNameReserved = newStatusError("Failure_Namespace_Root_Name_Reserved")
+	// Validation failed because the resulting namespace would exceed the maximum allowed namespace depth.
+	ErrNamespaceTooDeep = newStatusError("Failure_Namespace_Too_Deep")
+	// Validation failed because namespace parent is unknown.
+	ErrNamespaceParentUnknown = newStatusError("Failure_Namespace_Parent_Unknown")
+	// Validation failed because namespace already exists.
+	ErrNamespaceAlreadyExists = newStatusError("Failure_Namespace_Already_Exists")
+	// Validation failed because namespace is already active.
+	ErrNamespaceAlreadyActive = newStatusError("Failure_Namespace_Already_Active")
+	// Validation failed because an eternal namespace was received after the nemesis block.
+	ErrNamespaceEternalAfterNemesisBlock = newStatusError("Failure_Namespace_Eternal_After_Nemesis_Block")
+	// Validation failed because the maximum number of children for a root namespace was exceeded.
+	ErrNamespaceMaxChildrenExceeded = newStatusError("Failure_Namespace_Max_Children_Exceeded")
+	// Validation failed because alias action is invalid.
+	ErrNamespaceAliasInvalidAction = newStatusError("Failure_Namespace_Alias_Invalid_Action")
+	// Validation failed because namespace is unknown.
+	ErrNamespaceAliasNamespaceUnknown = newStatusError("Failure_Namespace_Alias_Namespace_Unknown")
+	// Validation failed because namespace is already linked to an alias.
+	ErrNamespaceAliasAlreadyExists = newStatusError("Failure_Namespace_Alias_Already_Exists")
+	// Validation failed because namespace is not linked to an alias.
+	ErrNamespaceAliasDoesNotExist = newStatusError("Failure_Namespace_Alias_Does_Not_Exist")
+	// Validation failed because namespace has a different owner.
+	ErrNamespaceAliasOwnerConflict = newStatusError("Failure_Namespace_Alias_Owner_Conflict")
+	// Validation failed because unlink type is not consistent with the existing alias.
+	ErrNamespaceAliasUnlinkTypeInconsistency = newStatusError("Failure_Namespace_Alias_Unlink_Type_Inconsistency")
+	// Validation failed because unlink data is not consistent with the existing alias.
+	ErrNamespaceAliasUnlinkDataInconsistency = newStatusError("Failure_Namespace_Alias_Unlink_Data_Inconsistency")
+	// Validation failed because aliased address is invalid.
+	ErrNamespaceAliasInvalidAddress = newStatusError("Failure_Namespace_Alias_Invalid_Address")
+)
+
+// Property transaction status errors
+var (
+	// Validation failed because the property type is invalid.
+	ErrPropertyInvalidPropertyType = newStatusError("Failure_Property_Invalid_Property_Type")
+	// Validation failed because a modification type is invalid.
+	ErrPropertyModificationTypeInvalid = newStatusError("Failure_Property_Modification_Type_Invalid")
+	// Validation failed because a modification address is invalid.
+	ErrPropertyModificationAddressInvalid = newStatusError("Failure_Property_Modification_Address_Invalid")
+	// Validation failed because the operation type is incompatible.
+	ErrPropertyModificationOperationTypeIncompatible = newStatusError("Failure_Property_Modification_Operation_Type_Incompatible")
+	// Validation failed because a modification is redundant.
+	ErrPropertyModificationRedundant = newStatusError("Failure_Property_Modification_Redundant")
+	// Validation failed because a value is not in the container.
+	ErrPropertyModificationNotAllowed = newStatusError("Failure_Property_Modification_Not_Allowed")
+	// Validation failed because there are too many modifications.
+	ErrPropertyModificationCountExceeded = newStatusError("Failure_Property_Modification_Count_Exceeded")
+	// Validation failed because there are too many values in the property.
+	ErrPropertyValuesCountExceeded = newStatusError("Failure_Property_Values_Count_Exceeded")
+	// Validation failed because the property value is invalid.
+	ErrPropertyValueInvalid = newStatusError("Failure_Property_Value_Invalid")
+	// Validation failed because the signer is not allowed to interact with the address involved.
+	ErrPropertySignerAddressInteractionNotAllowed = newStatusError("Failure_Property_Signer_Address_Interaction_Not_Allowed")
+	// Validation failed because the mosaic transfer is prohibited by the recipient.
+	ErrPropertyMosaicTransferNotAllowed = newStatusError("Failure_Property_Mosaic_Transfer_Not_Allowed")
+	// Validation failed because the transaction type is not allowed to be initiated by the signer.
+	ErrPropertyTransactionTypeNotAllowed = newStatusError("Failure_Property_Transaction_Type_Not_Allowed")
+)
+
+// Signature transaction status errors
+var (
+	// Validation failed because a signature is not verifiable.
+	ErrSignatureNotVerifiable = newStatusError("Failure_Signature_Not_Verifiable")
+)
+
+// Storage transaction status errors
+var (
+	// Validation failed because the drive already exists.
+	ErrStorageDriveAlreadyExists = newStatusError("Failure_Storage_Drive_Already_Exists")
+	// Validation failed because the drive is not found.
+	ErrStorageDriveNotFound = newStatusError("Failure_Storage_Drive_Not_Found")
+	// Validation failed because the replicator is already registered.
+	ErrStorageReplicatorAlreadyRegistered = newStatusError("Failure_Storage_Replicator_Already_Registered")
+	// Validation failed because the replicator is not found.
+	ErrStorageReplicatorNotFound = newStatusError("Failure_Storage_Replicator_Not_Found")
+	// Validation failed because replicators do not have enough free capacity.
+	ErrStorageInsufficientCapacity = newStatusError("Failure_Storage_Insufficient_Capacity")
+	// Validation failed because there are not enough replicators for the drive.
+	ErrStorageNotEnoughReplicators = newStatusError("Failure_Storage_Not_Enough_Replicators")
+	// Validation failed because the signer is not the owner of the drive.
+	ErrStorageIsNotOwner = newStatusError("Failure_Storage_Is_Not_Owner")
+	// Validation failed because the download channel is not found.
+	ErrStorageDownloadChannelNotFound = newStatusError("Failure_Storage_Download_Channel_Not_Found")
+)
+
+// SuperContract transaction status errors
+var (
+	// Validation failed because the super contract already exists.
+	ErrSuperContractSuperContractAlreadyExists = newStatusError("Failure_SuperContract_Super_Contract_Already_Exists")
+	// Validation failed because the super contract does not exist.
+	ErrSuperContractSuperContractDoesNotExist = newStatusError("Failure_SuperContract_Super_Contract_Does_Not_Exist")
+	// Validation failed because the drive of the super contract is not found.
+	ErrSuperContractDriveNotFound = newStatusError("Failure_SuperContract_Drive_Not_Found")
+	// Validation failed because an execution of the super contract is in progress.
+	ErrSuperContractExecutionIsInProgress = newStatusError("Failure_SuperContract_Execution_Is_In_Progress")
+	// Validation failed because the operation is not permitted for the signer.
+	ErrSuperContractOperationIsNotPermitted = newStatusError("Failure_SuperContract_Operation_Is_Not_Permitted")
+)
+
+// Transfer transaction status errors
+var (
+	// Validation failed because the message is too large.
+	ErrTransferMessageTooLarge = newStatusError("Failure_Transfer_Message_Too_Large")
+	// Validation failed because mosaics are out of order.
+	ErrTransferOutOfOrderMosaics = newStatusError("Failure_Transfer_Out_Of_Order_Mosaics")
+)
@@ -0,0 +1,61 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStatusError_Success(t *testing.T) {
+	assert.Nil(t, NewStatusError("Success"))
+	assert.Nil(t, (&TransactionStatus{Status: "Success"}).Err())
+}
+
+func TestNewStatusError_Catalogue(t *testing.T) {
+	err := (&StatusInfo{Status: "Failure_Core_Insufficient_Balance"}).Err()
+
+	assert.Equal(t, ErrCoreInsufficientBalance, err)
+	assert.True(t, errors.Is(fmt.Errorf("announce: %w", err), ErrCoreInsufficientBalance))
+	assert.False(t, errors.Is(err, ErrCorePastDeadline))
+	assert.True(t, IsUserFixable(err))
+}
+
+func TestNewStatusError_Unknown(t *testing.T) {
+	err := NewStatusError("Failure_LockSecret_Some_New_Result_Exists")
+
+	var statusErr *StatusError
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, "Failure", statusErr.Severity)
+	assert.Equal(t, "LockSecret", statusErr.Facility)
+	assert.Equal(t, "Some_New_Result_Exists", statusErr.Description)
+	assert.True(t, IsPermanent(err))
+	assert.True(t, errors.Is(err, NewStatusError("Failure_LockSecret_Some_New_Result_Exists")))
+}
+
+func TestStatusError_Class(t *testing.T) {
+	tests := []struct {
+		err   error
+		class StatusClass
+	}{
+		{ErrCoreFutureDeadline, StatusRetryable},
+		{ErrChainUnlinked, StatusRetryable},
+		{ErrLockHashUnknownHash, StatusRetryable},
+		{ErrCorePastDeadline, StatusUserFixable},
+		{ErrAggregateMissingCosigners, StatusUserFixable},
+		{ErrNamespaceAlreadyExists, StatusPermanent},
+		{ErrMosaicSupplyImmutable, StatusPermanent},
+		{ErrHashExists, StatusPermanent},
+		{NewStatusError("Neutral_Consumer_Hash_In_Recency_Cache"), StatusPermanent},
+		{errors.New("Failure_Core_Past_Deadline"), StatusClassUnknown},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.class, statusClass(tt.err), tt.err.Error())
+	}
+}
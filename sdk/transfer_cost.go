@@ -0,0 +1,245 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"time"
+)
+
+const DefaultLevyCacheTTL = time.Minute * 5
+
+// LevyCharge is the levy paid for transferring a levied mosaic
+type LevyCharge struct {
+	// MosaicId is the levied mosaic
+	MosaicId *MosaicId
+	Levy     *MosaicLevy
+	// Charge is paid in the levy mosaic to the levy recipient
+	Charge *Mosaic
+}
+
+// RecipientCost is the cost of a single transfer
+type RecipientCost struct {
+	Recipient *Address
+	// Mosaics are transferred mosaics with resolved mosaic ids
+	Mosaics []*Mosaic
+	Levies  []*LevyCharge
+	// MaxFee is the fee cap of the transfer, the node charges at most this amount
+	MaxFee Amount
+}
+
+// TransferCost is the total the sender pays for transfers
+type TransferCost struct {
+	Recipients []*RecipientCost
+	// CurrencyId is the mosaic of fees
+	CurrencyId *MosaicId
+	// MaxFee is the sum of fee caps of the transfers
+	MaxFee Amount
+	// Totals are transferred mosaics, levies and fee caps summed per mosaic
+	Totals []*Mosaic
+	// Shortfalls are amounts missing on the sender balance, empty when it covers the totals
+	Shortfalls []*Mosaic
+}
+
+// Covered returns false when the sender balance can not cover the totals
+func (c *TransferCost) Covered() bool {
+	return len(c.Shortfalls) == 0
+}
+
+// TransferCostCalculator calculates what transfers cost including mosaic levies and fees.
+// Levies are cached for LevyTTL
+type TransferCostCalculator struct {
+	Client   *Client
+	Resolver *NameResolver
+	// CurrencyId is the mosaic of fees. The mosaic linked to prx.xpx is used when it is nil
+	CurrencyId *MosaicId
+	LevyTTL    time.Duration
+
+	levies *ttlCache[MosaicId, *MosaicLevy]
+}
+
+// returns TransferCostCalculator with DefaultLevyCacheTTL
+func NewTransferCostCalculator(client *Client) *TransferCostCalculator {
+	return &TransferCostCalculator{
+		Client:   client,
+		Resolver: NewNameResolver(client),
+		LevyTTL:  DefaultLevyCacheTTL,
+		levies:   newTtlCache[MosaicId, *MosaicLevy](),
+	}
+}
+
+// Levy returns the levy of the mosaic or nil when the mosaic has no levy
+func (c *TransferCostCalculator) Levy(ctx context.Context, mosaicId *MosaicId) (*MosaicLevy, error) {
+	if mosaicId == nil {
+		return nil, ErrNilMosaicId
+	}
+
+	if levy, ok := c.levies.get(*mosaicId); ok {
+		return levy, nil
+	}
+
+	levy, err := c.Client.Mosaic.GetMosaicLevy(ctx, mosaicId)
+	switch {
+	case IsNotFound(err):
+		levy = nil
+	case err != nil:
+		return nil, err
+	}
+
+	if levy != nil && levy.Type == LevyNone {
+		levy = nil
+	}
+
+	c.levies.set(*mosaicId, levy, c.LevyTTL)
+
+	return levy, nil
+}
+
+// Cost returns what the sender pays for transfers. The balance of the sender is checked when sender is not nil
+func (c *TransferCostCalculator) Cost(ctx context.Context, sender *Address, transfers ...*TransferTransaction) (*TransferCost, error) {
+	currencyId, err := c.currency(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := &TransferCost{
+		Recipients: make([]*RecipientCost, 0, len(transfers)),
+		CurrencyId: currencyId,
+		Totals:     make([]*Mosaic, 0),
+		Shortfalls: make([]*Mosaic, 0),
+	}
+
+	totals := newMosaicTotals()
+	for _, tx := range transfers {
+		rc, err := c.recipientCost(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range rc.Mosaics {
+			totals.add(m.AssetId.(*MosaicId), m.Amount)
+		}
+		for _, l := range rc.Levies {
+			totals.add(l.Charge.AssetId.(*MosaicId), l.Charge.Amount)
+		}
+		totals.add(currencyId, rc.MaxFee)
+
+		cost.MaxFee += rc.MaxFee
+		cost.Recipients = append(cost.Recipients, rc)
+	}
+
+	cost.Totals = totals.mosaics()
+
+	if sender == nil {
+		return cost, nil
+	}
+
+	info, err := c.Client.Account.GetAccountInfo(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := newMosaicTotals()
+	for _, m := range info.Mosaics {
+		mosaicId, err := c.Resolver.MosaicIdOf(ctx, m.AssetId)
+		if err != nil {
+			return nil, err
+		}
+
+		balances.add(mosaicId, m.Amount)
+	}
+
+	for _, total := range cost.Totals {
+		mosaicId := total.AssetId.(*MosaicId)
+		if balance := balances.get(mosaicId); balance < total.Amount {
+			cost.Shortfalls = append(cost.Shortfalls, newMosaicPanic(mosaicId, total.Amount-balance))
+		}
+	}
+
+	return cost, nil
+}
+
+func (c *TransferCostCalculator) recipientCost(ctx context.Context, tx *TransferTransaction) (*RecipientCost, error) {
+	if tx == nil {
+		return nil, ErrNilTransferTransaction
+	}
+
+	rc := &RecipientCost{
+		Recipient: tx.Recipient,
+		Mosaics:   make([]*Mosaic, 0, len(tx.Mosaics)),
+		Levies:    make([]*LevyCharge, 0),
+		MaxFee:    tx.MaxFee,
+	}
+
+	for _, m := range tx.Mosaics {
+		mosaicId, err := c.Resolver.MosaicIdOf(ctx, m.AssetId)
+		if err != nil {
+			return nil, err
+		}
+
+		rc.Mosaics = append(rc.Mosaics, newMosaicPanic(mosaicId, m.Amount))
+
+		levy, err := c.Levy(ctx, mosaicId)
+		if err != nil {
+			return nil, err
+		}
+
+		if levy == nil || m.Amount == 0 {
+			continue
+		}
+
+		levyMosaicId := levy.MosaicId
+		if levyMosaicId == nil {
+			levyMosaicId = mosaicId
+		}
+
+		rc.Levies = append(rc.Levies, &LevyCharge{
+			MosaicId: mosaicId,
+			Levy:     levy,
+			Charge:   newMosaicPanic(levyMosaicId, levy.Amount(m.Amount)),
+		})
+	}
+
+	return rc, nil
+}
+
+func (c *TransferCostCalculator) currency(ctx context.Context) (*MosaicId, error) {
+	if c.CurrencyId != nil {
+		return c.CurrencyId, nil
+	}
+
+	return c.Resolver.MosaicIdOf(ctx, XpxNamespaceId)
+}
+
+// mosaicTotals sums amounts per mosaic keeping the order of first addition
+type mosaicTotals struct {
+	ids     []*MosaicId
+	amounts map[MosaicId]Amount
+}
+
+func newMosaicTotals() *mosaicTotals {
+	return &mosaicTotals{amounts: make(map[MosaicId]Amount)}
+}
+
+func (t *mosaicTotals) add(mosaicId *MosaicId, amount Amount) {
+	if _, ok := t.amounts[*mosaicId]; !ok {
+		t.ids = append(t.ids, mosaicId)
+	}
+
+	t.amounts[*mosaicId] += amount
+}
+
+func (t *mosaicTotals) get(mosaicId *MosaicId) Amount {
+	return t.amounts[*mosaicId]
+}
+
+func (t *mosaicTotals) mosaics() []*Mosaic {
+	mosaics := make([]*Mosaic, 0, len(t.ids))
+	for _, id := range t.ids {
+		mosaics = append(mosaics, newMosaicPanic(id, t.amounts[*id]))
+	}
+
+	return mosaics
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMosaicLevies struct {
+	MosaicService
+	levies map[MosaicId]*MosaicLevy
+	calls  int
+}
+
+func (s *fakeMosaicLevies) GetMosaicLevy(_ context.Context, mosaicId *MosaicId) (*MosaicLevy, error) {
+	s.calls++
+
+	levy, ok := s.levies[*mosaicId]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return levy, nil
+}
+
+type fakeAccountBalance struct {
+	AccountService
+	mosaics []*Mosaic
+}
+
+func (s *fakeAccountBalance) GetAccountInfo(_ context.Context, address *Address) (*AccountInfo, error) {
+	return &AccountInfo{Address: address, Mosaics: s.mosaics}, nil
+}
+
+func TestTransferCostCalculator_Cost(t *testing.T) {
+	config, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, time.Second, nil, DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	xpx, err := NewMosaicId(0x0DC67FBE1CAD29E3)
+	require.NoError(t, err)
+	percentile, err := NewMosaicId(0x1111)
+	require.NoError(t, err)
+	absolute, err := NewMosaicId(0x2222)
+	require.NoError(t, err)
+
+	sender, err := NewAddressFromRaw("VAWOEOWTABXR7O3ZAK2XNA5GIBNE6PZIXDAFDWBU")
+	require.NoError(t, err)
+	issuer, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	levies := &fakeMosaicLevies{levies: map[MosaicId]*MosaicLevy{
+		*percentile: {Type: LevyPercentileFee, Recipient: issuer.Address, Fee: CreateMosaicLevyFeePercentile(1.5), MosaicId: xpx},
+		*absolute:   {Type: LevyAbsoluteFee, Recipient: issuer.Address, Fee: 10},
+	}}
+
+	client := NewClient(nil, config)
+	client.Namespace = &fakeAliases{mosaics: map[NamespaceId]*MosaicId{*XpxNamespaceId: xpx}}
+	client.Mosaic = levies
+	client.Account = &fakeAccountBalance{mosaics: []*Mosaic{
+		newMosaicPanic(XpxNamespaceId, 1000),
+		newMosaicPanic(percentile, 1201),
+		newMosaicPanic(absolute, 10),
+	}}
+
+	alice, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+	bob, err := NewAccount(PublicTest, &Hash{})
+	require.NoError(t, err)
+
+	toAlice, err := NewTransferTransaction(NewDeadline(time.Hour), alice.Address, []*Mosaic{
+		newMosaicPanic(XpxNamespaceId, 100),
+		newMosaicPanic(percentile, 1001),
+	}, NewPlainMessage(""), PublicTest)
+	require.NoError(t, err)
+	toAlice.MaxFee = 50
+
+	toBob, err := NewTransferTransaction(NewDeadline(time.Hour), bob.Address, []*Mosaic{
+		newMosaicPanic(percentile, 200),
+		newMosaicPanic(absolute, 5),
+	}, NewPlainMessage(""), PublicTest)
+	require.NoError(t, err)
+	toBob.MaxFee = 50
+
+	calculator := NewTransferCostCalculator(client)
+	cost, err := calculator.Cost(ctx, sender, toAlice, toBob)
+	require.NoError(t, err)
+
+	assert.Equal(t, xpx, cost.CurrencyId)
+	assert.Equal(t, Amount(100), cost.MaxFee)
+	require.Len(t, cost.Recipients, 2)
+
+	// 1.5% of 1001 is rounded down
+	alicesCost := cost.Recipients[0]
+	assert.Equal(t, alice.Address, alicesCost.Recipient)
+	assert.Equal(t, []*Mosaic{newMosaicPanic(xpx, 100), newMosaicPanic(percentile, 1001)}, alicesCost.Mosaics)
+	require.Len(t, alicesCost.Levies, 1)
+	assert.Equal(t, percentile, alicesCost.Levies[0].MosaicId)
+	assert.Equal(t, newMosaicPanic(xpx, 15), alicesCost.Levies[0].Charge)
+
+	// absolute levies without a levy mosaic are paid in the levied mosaic
+	bobsCost := cost.Recipients[1]
+	require.Len(t, bobsCost.Levies, 2)
+	assert.Equal(t, newMosaicPanic(xpx, 3), bobsCost.Levies[0].Charge)
+	assert.Equal(t, newMosaicPanic(absolute, 10), bobsCost.Levies[1].Charge)
+
+	assert.Equal(t, []*Mosaic{
+		newMosaicPanic(xpx, 100+15+50+3+50),
+		newMosaicPanic(percentile, 1201),
+		newMosaicPanic(absolute, 15),
+	}, cost.Totals)
+
+	assert.False(t, cost.Covered())
+	assert.Equal(t, []*Mosaic{newMosaicPanic(absolute, 5)}, cost.Shortfalls)
+
+	// levies are fetched once per mosaic
+	assert.Equal(t, 3, levies.calls)
+
+	cost, err = calculator.Cost(ctx, nil, toAlice)
+	require.NoError(t, err)
+	assert.True(t, cost.Covered())
+	assert.Equal(t, 3, levies.calls)
+
+	_, err = calculator.Cost(ctx, nil, toAlice, nil)
+	assert.Equal(t, ErrNilTransferTransaction, err)
+}
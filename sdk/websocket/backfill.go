@@ -0,0 +1,498 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket/subs"
+)
+
+const (
+	backfillBlocksLimit       sdk.Amount = 100
+	backfillTransactionsLimit uint64     = 100
+	// recentHeights is how many heights below the last seen one keep hashes for deduplication
+	recentHeights sdk.Height = 32
+)
+
+// Backfill fetches notifications which were published while the client was disconnected
+type Backfill interface {
+	Height(ctx context.Context) (sdk.Height, error)
+	// Blocks returns blocks from..to in order
+	Blocks(ctx context.Context, from, to sdk.Height) ([]*sdk.BlockInfo, error)
+	// ConfirmedTransactions returns top level transactions of the address confirmed from..to in order
+	ConfirmedTransactions(ctx context.Context, address *sdk.Address, from, to sdk.Height) ([]sdk.Transaction, error)
+}
+
+type restBackfill struct {
+	client *sdk.Client
+}
+
+// returns Backfill which fetches blocks and transactions over REST
+func NewRestBackfill(client *sdk.Client) Backfill {
+	return &restBackfill{client: client}
+}
+
+func (b *restBackfill) Height(ctx context.Context) (sdk.Height, error) {
+	return b.client.Blockchain.GetBlockchainHeight(ctx)
+}
+
+func (b *restBackfill) Blocks(ctx context.Context, from, to sdk.Height) ([]*sdk.BlockInfo, error) {
+	blocks := make([]*sdk.BlockInfo, 0)
+	for height := from; height <= to; height += sdk.Height(backfillBlocksLimit) {
+		infos, err := b.client.Blockchain.GetBlocksByHeightWithLimit(ctx, height, backfillBlocksLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range infos {
+			if info.Height >= from && info.Height <= to {
+				blocks = append(blocks, info)
+			}
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Height < blocks[j].Height
+	})
+
+	return blocks, nil
+}
+
+func (b *restBackfill) ConfirmedTransactions(ctx context.Context, address *sdk.Address, from, to sdk.Height) ([]sdk.Transaction, error) {
+	txs := make([]sdk.Transaction, 0)
+	for page := uint64(1); ; page++ {
+		txPage, err := b.client.Transaction.GetTransactionsByGroup(ctx, sdk.Confirmed, &sdk.TransactionsPageOptions{
+			Address:    address.Address,
+			FromHeight: uint64(from),
+			ToHeight:   uint64(to),
+			FirstLevel: true,
+			PaginationOrderingOptions: sdk.PaginationOrderingOptions{
+				PageSize:   backfillTransactionsLimit,
+				PageNumber: page,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, txPage.Transactions...)
+
+		if page >= txPage.Pagination.TotalPages {
+			break
+		}
+	}
+
+	sort.SliceStable(txs, func(i, j int) bool {
+		a, b := txs[i].GetAbstractTransaction().TransactionInfo, txs[j].GetAbstractTransaction().TransactionInfo
+		if a.Height != b.Height {
+			return a.Height < b.Height
+		}
+
+		return a.Index < b.Index
+	})
+
+	return txs, nil
+}
+
+// cursor is the last seen height of a subscribed path and hashes of recent notifications
+type cursor struct {
+	path   *subs.Path
+	height sdk.Height
+	seen   map[sdk.Hash]sdk.Height
+}
+
+// accept returns false when the notification is already delivered
+func (c *cursor) accept(hash *sdk.Hash, height sdk.Height) bool {
+	if hash == nil {
+		return true
+	}
+
+	if _, ok := c.seen[*hash]; ok {
+		return false
+	}
+	c.seen[*hash] = height
+
+	if height > c.height {
+		c.height = height
+
+		for h, seenHeight := range c.seen {
+			if seenHeight+recentHeights < c.height {
+				delete(c.seen, h)
+			}
+		}
+	}
+
+	return true
+}
+
+// cursors track delivered blocks and confirmed transactions, so notifications are delivered once across reconnections
+type cursors struct {
+	m      sync.Mutex
+	byPath map[string]*cursor
+	// height is the last height known to the client, cursors of new paths start from it
+	height sdk.Height
+	// replays is the number of started replays. Live notifications of tracked paths are held
+	// until replays are finished, so they follow the replayed ones
+	replays int
+	held    []*replay
+}
+
+func newCursors() *cursors {
+	return &cursors{byPath: make(map[string]*cursor)}
+}
+
+// backfilled returns true for topics which Backfill can fetch
+func backfilled(topic subs.Topic) bool {
+	return topic == topicBlock || topic == topicConfirmedAdded
+}
+
+func (c *cursors) start(path *subs.Path) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if _, ok := c.byPath[path.String()]; ok {
+		return
+	}
+
+	c.byPath[path.String()] = &cursor{
+		path:   path,
+		height: c.height,
+		seen:   make(map[sdk.Hash]sdk.Height),
+	}
+}
+
+func (c *cursors) stop(path *subs.Path) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	delete(c.byPath, path.String())
+}
+
+// advance raises the known height. Cursors started before any height was known start from it too
+func (c *cursors) advance(height sdk.Height) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if height > c.height {
+		c.height = height
+	}
+
+	for _, cur := range c.byPath {
+		if cur.height == 0 {
+			cur.height = c.height
+		}
+	}
+}
+
+func (c *cursors) beginReplay() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.replays++
+}
+
+// hold keeps the live notification while a replay is running. It returns false when the notification
+// should be delivered at once
+func (c *cursors) hold(r *replay) bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.replays == 0 {
+		return false
+	}
+
+	if _, ok := c.byPath[r.path.String()]; !ok {
+		return false
+	}
+
+	c.held = append(c.held, r)
+	return true
+}
+
+// endReplay returns notifications held during the replay. The replay is finished when nothing is held
+func (c *cursors) endReplay() []*replay {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if len(c.held) > 0 {
+		held := c.held
+		c.held = nil
+		return held
+	}
+
+	c.replays--
+	return nil
+}
+
+func (c *cursors) accept(path *subs.Path, hash *sdk.Hash, height sdk.Height) bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if height > c.height {
+		c.height = height
+	}
+
+	cur, ok := c.byPath[path.String()]
+	if !ok {
+		return true
+	}
+
+	return cur.accept(hash, height)
+}
+
+// snapshot returns cursors of subscribed paths and forgets the others
+func (c *cursors) snapshot(paths []string) []*cursor {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	subscribed := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		subscribed[p] = true
+	}
+
+	res := make([]*cursor, 0, len(c.byPath))
+	for p, cur := range c.byPath {
+		if !subscribed[p] {
+			delete(c.byPath, p)
+			continue
+		}
+
+		res = append(res, &cursor{path: cur.path, height: cur.height})
+	}
+
+	return res
+}
+
+// trackingMapper skips notifications which are already delivered and holds live ones during a replay
+type trackingMapper[T any] struct {
+	mapper  sdk.Mapper[T]
+	cursors *cursors
+	topics  Topics
+	topic   subs.Topic
+	key     func(T) (*sdk.Hash, sdk.Height)
+}
+
+func (m *trackingMapper[T]) Map(payload []byte) (T, error) {
+	v, err := m.mapper.Map(payload)
+	if err != nil || m.cursors == nil {
+		return v, err
+	}
+
+	info, err := subs.MapMessageInfo(payload)
+	if err != nil {
+		return v, err
+	}
+
+	path := subs.PathFromWsMessageInfo(info)
+	hash, height := m.key(v)
+
+	held := m.cursors.hold(&replay{
+		height:  height,
+		isBlock: m.topic == topicBlock,
+		path:    path,
+		hash:    hash,
+		deliver: func(ctx context.Context) error {
+			pool, err := typedPool[T](m.topics, m.topic, nil)
+			if err != nil {
+				return err
+			}
+
+			return pool.Deliver(ctx, path, v)
+		},
+	})
+	if held || !m.cursors.accept(path, hash, height) {
+		return v, subs.ErrSkipNotification
+	}
+
+	return v, nil
+}
+
+func blockKey(info *sdk.BlockInfo) (*sdk.Hash, sdk.Height) {
+	return info.BlockHash, info.Height
+}
+
+func transactionKey(tx sdk.Transaction) (*sdk.Hash, sdk.Height) {
+	info := tx.GetAbstractTransaction().TransactionInfo
+	return info.TransactionHash, info.Height
+}
+
+// replay is a missed notification. Blocks are replayed before transactions of the same height
+type replay struct {
+	height  sdk.Height
+	isBlock bool
+	path    *subs.Path
+	hash    *sdk.Hash
+	deliver func(ctx context.Context) error
+}
+
+// startCursors sets the height which cursors of subscriptions start from
+func (c *CatapultWebsocketClientImpl) startCursors(ctx context.Context) {
+	if c.cursors == nil {
+		return
+	}
+
+	height, err := c.policy.Backfill.Height(ctx)
+	if err != nil {
+		log.Printf("websocket: cannot get height for backfill: %s\n", err)
+		return
+	}
+
+	c.cursors.advance(height)
+}
+
+// replay runs the backfill after reconnection and then delivers live notifications held meanwhile.
+// It does not block reading of the connection, replays of successive reconnections run one after another
+func (c *CatapultWebsocketClientImpl) replay(ctx context.Context, gap Gap) {
+	c.replayMutex.Lock()
+	defer c.replayMutex.Unlock()
+
+	gap.Backfilled = c.backfill(ctx)
+
+	for held := c.cursors.endReplay(); held != nil; held = c.cursors.endReplay() {
+		if !c.deliver(ctx, held) {
+			gap.Backfilled = false
+		}
+	}
+
+	c.notifyConnection(&ConnectionEvent{State: Backfilled, Gap: &gap})
+}
+
+// deliver sends notifications which are not delivered yet. It returns false when some of them are not received
+func (c *CatapultWebsocketClientImpl) deliver(ctx context.Context, replays []*replay) bool {
+	complete := true
+	for _, r := range replays {
+		if !c.cursors.accept(r.path, r.hash, r.height) {
+			continue
+		}
+
+		if err := r.deliver(ctx); err != nil {
+			log.Printf("websocket: cannot replay to %s: %s\n", r.path, err)
+			complete = false
+		}
+	}
+
+	return complete
+}
+
+// backfill replays notifications missed by subscribed paths in order of heights. It returns false when
+// some of them can not be fetched
+func (c *CatapultWebsocketClientImpl) backfill(ctx context.Context) bool {
+	to, err := c.policy.Backfill.Height(ctx)
+	if err != nil {
+		log.Printf("websocket: cannot get height for backfill: %s\n", err)
+		return false
+	}
+
+	complete := true
+	replays := make([]*replay, 0)
+	for _, cur := range c.cursors.snapshot(c.topics.Paths()) {
+		var missed []*replay
+		var err error
+		switch cur.path.Topic() {
+		case topicBlock:
+			missed, err = c.missedBlocks(ctx, cur, to)
+		case topicConfirmedAdded:
+			missed, err = c.missedTransactions(ctx, cur, to)
+		}
+
+		if err != nil {
+			log.Printf("websocket: cannot backfill %s: %s\n", cur.path, err)
+			complete = false
+			continue
+		}
+
+		replays = append(replays, missed...)
+	}
+
+	sort.SliceStable(replays, func(i, j int) bool {
+		if replays[i].height != replays[j].height {
+			return replays[i].height < replays[j].height
+		}
+
+		return replays[i].isBlock && !replays[j].isBlock
+	})
+
+	if !c.deliver(ctx, replays) {
+		complete = false
+	}
+
+	c.cursors.advance(to)
+
+	return complete
+}
+
+func (c *CatapultWebsocketClientImpl) missedBlocks(ctx context.Context, cur *cursor, to sdk.Height) ([]*replay, error) {
+	if cur.height == 0 || cur.height >= to {
+		return nil, nil
+	}
+
+	pool, err := deliveryPool[*sdk.BlockInfo](c, topicBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := c.policy.Backfill.Blocks(ctx, cur.height+1, to)
+	if err != nil {
+		return nil, err
+	}
+
+	replays := make([]*replay, 0, len(blocks))
+	for _, info := range blocks {
+		info := info
+		replays = append(replays, &replay{
+			height:  info.Height,
+			isBlock: true,
+			path:    cur.path,
+			hash:    info.BlockHash,
+			deliver: func(ctx context.Context) error {
+				return pool.Deliver(ctx, cur.path, info)
+			},
+		})
+	}
+
+	return replays, nil
+}
+
+func (c *CatapultWebsocketClientImpl) missedTransactions(ctx context.Context, cur *cursor, to sdk.Height) ([]*replay, error) {
+	// transactions of the last seen height are fetched again, because only some of them could be delivered
+	if cur.height == 0 || cur.height > to {
+		return nil, nil
+	}
+
+	pool, err := deliveryPool[sdk.Transaction](c, topicConfirmedAdded)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := c.policy.Backfill.ConfirmedTransactions(ctx, cur.path.Address(), cur.height, to)
+	if err != nil {
+		return nil, err
+	}
+
+	replays := make([]*replay, 0, len(txs))
+	for _, tx := range txs {
+		tx := tx
+		hash, height := transactionKey(tx)
+		replays = append(replays, &replay{
+			height: height,
+			path:   cur.path,
+			hash:   hash,
+			deliver: func(ctx context.Context) error {
+				return pool.Deliver(ctx, cur.path, tx)
+			},
+		})
+	}
+
+	return replays, nil
+}
+
+func deliveryPool[T any](c *CatapultWebsocketClientImpl, topic subs.Topic) (subs.SubscribersPool[T], error) {
+	return typedPool[T](c.topics, topic, nil)
+}
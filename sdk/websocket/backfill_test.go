@@ -0,0 +1,181 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket/subs"
+	"github.com/proximax-storage/go-xpx-chain-sdk/test/fakenode"
+)
+
+func receiveT[T any](t *testing.T, sub <-chan T) T {
+	select {
+	case v := <-sub:
+		return v
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "notification is not received")
+		var v T
+		return v
+	}
+}
+
+func announceTransferT(t *testing.T, client *sdk.Client, signer *sdk.Account, recipient *sdk.Address, message string) *sdk.Hash {
+	tx, err := client.NewTransferTransaction(sdk.NewDeadline(time.Hour), recipient, []*sdk.Mosaic{}, sdk.NewPlainMessage(message))
+	require.NoError(t, err)
+	signed, err := signer.Sign(tx)
+	require.NoError(t, err)
+	_, err = client.Transaction.Announce(context.Background(), signed)
+	require.NoError(t, err)
+
+	return signed.Hash
+}
+
+func TestCatapultWebsocketClientImpl_Backfill(t *testing.T) {
+	ctx := context.Background()
+
+	node := fakenode.New(fakenode.Config{})
+	defer node.Close()
+
+	config, err := sdk.NewConfig(ctx, []string{node.URL()})
+	require.NoError(t, err)
+	client := sdk.NewClient(nil, config)
+
+	policy := DefaultConnectionPolicy(config)
+	policy.InitialInterval = time.Millisecond * 10
+	policy.MaxInterval = time.Millisecond * 50
+	require.NotNil(t, policy.Backfill)
+	ws, err := NewClientWithPolicy(config, policy)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	events, _, err := ws.NewConnectionSubscription()
+	require.NoError(t, err)
+	assert.Equal(t, Connected, receiveT(t, events).State)
+
+	signer, err := client.NewAccount()
+	require.NoError(t, err)
+	recipient, err := client.NewAccount()
+	require.NoError(t, err)
+
+	blocks, _, err := ws.NewBlockSubscription()
+	require.NoError(t, err)
+	// subscriptions made through Subscribe are backfilled too
+	txs, _, err := Subscribe[sdk.Transaction](ws, topicConfirmedAdded, recipient.Address, nil)
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go ws.Listen(runCtx)
+
+	require.Eventually(t, func() bool {
+		return node.HasSubscribers("block") && node.HasSubscribers("confirmedAdded/"+recipient.Address.Address)
+	}, time.Second*5, time.Millisecond*10)
+
+	first := announceTransferT(t, client, signer, recipient.Address, "first")
+	height := node.GenerateBlock()
+	assert.Equal(t, height, receiveT(t, blocks).Height)
+	assert.Equal(t, first.String(), receiveT(t, txs).GetAbstractTransaction().TransactionHash.String())
+
+	// blocks and transactions of the outage are lost by the websocket
+	node.PauseWebsocket()
+	assert.Equal(t, Reconnecting, receiveT(t, events).State)
+
+	missed := make([]*sdk.Hash, 0)
+	for i := 0; i < 3; i++ {
+		missed = append(missed, announceTransferT(t, client, signer, recipient.Address, fmt.Sprintf("missed %d", i)))
+		node.GenerateBlock()
+	}
+	node.ResumeWebsocket()
+
+	// the connection is reported as established while the replay waits for slow subscribers
+	var e *ConnectionEvent
+	for e = receiveT(t, events); e.State != Connected; e = receiveT(t, events) {
+	}
+	require.NotNil(t, e.Gap)
+	assert.ElementsMatch(t, []string{"block", "confirmedAdded/" + recipient.Address.Address}, e.Gap.Paths)
+
+	// a live notification during the replay is held until missed ones are delivered
+	require.Eventually(t, func() bool {
+		return node.HasSubscribers("block") && node.HasSubscribers("confirmedAdded/"+recipient.Address.Address)
+	}, time.Second*5, time.Millisecond*10)
+	during := announceTransferT(t, client, signer, recipient.Address, "during")
+	node.GenerateBlock()
+	missed = append(missed, during)
+
+	for i, hash := range missed {
+		assert.Equal(t, height+sdk.Height(i+1), receiveT(t, blocks).Height)
+		assert.Equal(t, hash.String(), receiveT(t, txs).GetAbstractTransaction().TransactionHash.String())
+	}
+
+	e = receiveT(t, events)
+	assert.Equal(t, Backfilled, e.State)
+	require.NotNil(t, e.Gap)
+	assert.True(t, e.Gap.Backfilled)
+
+	// live notifications continue after the replayed ones without duplicates
+	last := announceTransferT(t, client, signer, recipient.Address, "last")
+	height = node.GenerateBlock()
+	assert.Equal(t, height, receiveT(t, blocks).Height)
+	assert.Equal(t, last.String(), receiveT(t, txs).GetAbstractTransaction().TransactionHash.String())
+
+	select {
+	case info := <-blocks:
+		assert.Failf(t, "duplicate block", "height %s", info.Height)
+	case tx := <-txs:
+		assert.Failf(t, "duplicate transaction", "%s", tx)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func TestCursors_Accept(t *testing.T) {
+	c := newCursors()
+	c.advance(10)
+
+	path := subs.NewPath(topicBlock, nil)
+	c.start(path)
+
+	hash := &sdk.Hash{1}
+	assert.True(t, c.accept(path, hash, 11))
+	assert.False(t, c.accept(path, hash, 11))
+
+	// a block of another fork at the same height is delivered
+	assert.True(t, c.accept(path, &sdk.Hash{2}, 11))
+
+	snapshot := c.snapshot([]string{"block"})
+	require.Len(t, snapshot, 1)
+	assert.Equal(t, sdk.Height(11), snapshot[0].height)
+
+	assert.Empty(t, c.snapshot(nil))
+	assert.True(t, c.accept(path, hash, 11))
+}
+
+func TestCursors_HoldDuringReplay(t *testing.T) {
+	c := newCursors()
+
+	// the cursor is started before the client knows any height
+	path := subs.NewPath(topicBlock, nil)
+	c.start(path)
+	c.advance(10)
+	assert.Equal(t, sdk.Height(10), c.snapshot([]string{"block"})[0].height)
+
+	live := &replay{height: 12, isBlock: true, path: path, hash: &sdk.Hash{2}}
+	assert.False(t, c.hold(live))
+
+	c.beginReplay()
+	assert.True(t, c.hold(live))
+	assert.False(t, c.hold(&replay{height: 12, path: subs.NewPath(topicStatus, nil)}))
+
+	assert.Equal(t, []*replay{live}, c.endReplay())
+	assert.Nil(t, c.endReplay())
+	assert.False(t, c.hold(live))
+}
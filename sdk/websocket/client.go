@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"net/url"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -34,8 +36,18 @@ const (
 
 var (
 	ErrUnsupportedMessageType = errors.New("unsupported message type")
+	ErrNoTopics               = errors.New("websocket client does not support topic subscriptions")
+	ErrTopicTypeMismatch      = errors.New("topic is already subscribed with another notification type")
+	ErrSubscriptionNotFound   = errors.New("topic has no subscriptions")
+	ErrNilConnectionPolicy    = errors.New("connection policy should not be nil")
+	ErrConnectionClosed       = errors.New("websocket connection is closed")
+	ErrHeartbeatTimeout       = errors.New("node did not respond to ping in time")
+	ErrReconnectionExhausted  = errors.New("reconnection attempts are exhausted")
 )
 
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name Client --output ../../mocks/websocket --outpkg mocks
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name CatapultClient --output ../../mocks/websocket --outpkg mocks
+
 type (
 	// Subscribe path
 	//Path string
@@ -44,22 +56,23 @@ type (
 		UID    string
 		config *sdk.Config
 
-		conn *websocket.Conn
+		connMutex sync.Mutex
+		conn      *websocket.Conn
+
+		policy      *ConnectionPolicy
+		connections *connectionListeners
+		cursors     *cursors
+		replayMutex sync.Mutex
+		// lastMessage is the time of the last received message, the gap after reconnection starts from it
+		lastMessage time.Time
 
-		blockSubs          subs.SubscribersPool[*sdk.BlockInfo]
-		cosignatureSubs    subs.SubscribersPool[*sdk.SignerInfo]
-		driveStateSubs     subs.SubscribersPool[*sdk.DriveStateInfo]
-		confAddedSubs      subs.SubscribersPool[sdk.Transaction]
-		partialAddedSubs   subs.SubscribersPool[*sdk.AggregateTransaction]
-		partialRemovedSubs subs.SubscribersPool[*sdk.PartialRemovedInfo]
-		statusSubs         subs.SubscribersPool[*sdk.StatusInfo]
-		unconfAddedSubs    subs.SubscribersPool[sdk.Transaction]
-		unconfRemovedSubs  subs.SubscribersPool[*sdk.UnconfirmedRemoved]
+		topics *topics
 
 		publisher        *subs.Publisher
 		messagePublisher MessagePublisher
 
 		listening atomic.Bool
+		closed    atomic.Bool
 	}
 
 	Client interface {
@@ -72,6 +85,13 @@ type (
 		Client
 
 		Config() *sdk.Config
+		// Topics is used by Subscribe and Unsubscribe
+		Topics() Topics
+
+		// NewConnectionSubscription returns changes of the connection state starting from the current state.
+		// The channel is closed by ConnectionUnsubscribe
+		NewConnectionSubscription() (sub <-chan *ConnectionEvent, subId int, err error)
+		ConnectionUnsubscribe(subId int) error
 
 		NewBlockSubscription() (sub <-chan *sdk.BlockInfo, subId int, err error)
 		BlockUnsubscribe(subId int) error
@@ -103,61 +123,35 @@ type (
 )
 
 func NewClient(cfg *sdk.Config) (CatapultClient, error) {
-	newSubs := make(map[subs.Topic]subs.Notifier)
-
-	blockSubPools := subs.NewSubscribersPool[*sdk.BlockInfo](sdk.NewMapper[*sdk.BlockInfo](cfg.GenerationHash, sdk.BlockMapperFunc))
-	newSubs[subs.TopicBlock] = blockSubPools
-
-	cosignatureSubs := subs.NewSubscribersPool[*sdk.SignerInfo](sdk.NewMapper[*sdk.SignerInfo](cfg.GenerationHash, sdk.CosignatureMapperFunc))
-	newSubs[subs.TopicCosignature] = cosignatureSubs
-
-	driveStateSubs := subs.NewSubscribersPool[*sdk.DriveStateInfo](sdk.NewMapper[*sdk.DriveStateInfo](cfg.GenerationHash, sdk.DriveStateMapperFunc))
-	newSubs[subs.TopicDriveState] = driveStateSubs
-
-	confAddedSubs := subs.NewSubscribersPool[sdk.Transaction](sdk.NewMapper[sdk.Transaction](cfg.GenerationHash, sdk.TransactionMapperFunc))
-	newSubs[subs.TopicConfirmedAdded] = confAddedSubs
-
-	partialAddedSubs := subs.NewSubscribersPool[*sdk.AggregateTransaction](sdk.NewMapper[*sdk.AggregateTransaction](cfg.GenerationHash, sdk.AggregateTransactionMapperFunc))
-	newSubs[subs.TopicPartialAdded] = partialAddedSubs
-
-	partialRemovedSubs := subs.NewSubscribersPool[*sdk.PartialRemovedInfo](sdk.NewMapper[*sdk.PartialRemovedInfo](cfg.GenerationHash, sdk.PartialRemovedMapperFunc))
-	newSubs[subs.TopicPartialRemoved] = partialRemovedSubs
-
-	statusSubs := subs.NewSubscribersPool[*sdk.StatusInfo](sdk.NewMapper[*sdk.StatusInfo](cfg.GenerationHash, sdk.StatusMapperFunc))
-	newSubs[subs.TopicStatus] = statusSubs
+	return NewClientWithPolicy(cfg, DefaultConnectionPolicy(cfg))
+}
 
-	unconfAddedSubs := subs.NewSubscribersPool[sdk.Transaction](sdk.NewMapper[sdk.Transaction](cfg.GenerationHash, sdk.TransactionMapperFunc))
-	newSubs[subs.TopicUnconfirmedAdded] = unconfAddedSubs
+// returns CatapultClient which reconnects and detects lost connections according to the policy
+func NewClientWithPolicy(cfg *sdk.Config, policy *ConnectionPolicy) (CatapultClient, error) {
+	if policy == nil {
+		return nil, ErrNilConnectionPolicy
+	}
 
-	unconfRemovedSubs := subs.NewSubscribersPool[*sdk.UnconfirmedRemoved](sdk.NewMapper[*sdk.UnconfirmedRemoved](cfg.GenerationHash, sdk.UnconfirmedRemovedMapperFunc))
-	newSubs[subs.TopicUnconfirmedRemoved] = unconfRemovedSubs
+	socketClient := &CatapultWebsocketClientImpl{
+		config:      cfg,
+		policy:      policy,
+		connections: newConnectionListeners(),
+		publisher:   subs.NewPublisher(),
+	}
+	socketClient.topics = newTopics(socketClient)
 
-	var err error
-	publisher := subs.NewPublisher()
-	for topic, notifier := range newSubs {
-		err = publisher.AddSubscriber(topic, notifier)
-		if err != nil {
-			return nil, err
-		}
+	if policy.Backfill != nil {
+		socketClient.cursors = newCursors()
 	}
 
-	socketClient := &CatapultWebsocketClientImpl{
-		config:             cfg,
-		blockSubs:          blockSubPools,
-		cosignatureSubs:    cosignatureSubs,
-		driveStateSubs:     driveStateSubs,
-		confAddedSubs:      confAddedSubs,
-		partialAddedSubs:   partialAddedSubs,
-		partialRemovedSubs: partialRemovedSubs,
-		statusSubs:         statusSubs,
-		unconfAddedSubs:    unconfAddedSubs,
-		unconfRemovedSubs:  unconfRemovedSubs,
-		publisher:          publisher,
+	if err := socketClient.registerTopics(); err != nil {
+		return nil, err
 	}
 
 	if err := socketClient.initNewConnection(); err != nil {
 		return nil, err
 	}
+	socketClient.notifyConnection(&ConnectionEvent{State: Connected})
 
 	return socketClient, nil
 }
@@ -167,10 +161,15 @@ func (c *CatapultWebsocketClientImpl) Listen(ctx context.Context) {
 		return
 	}
 
+	go c.startCursors(ctx)
+
 	c.startMessageReading(ctx)
 }
 
 func (c *CatapultWebsocketClientImpl) Close() error {
+	c.closed.Store(true)
+	c.notifyConnection(&ConnectionEvent{State: Closed})
+
 	return c.closeConnection()
 }
 
@@ -178,79 +177,143 @@ func (c *CatapultWebsocketClientImpl) Config() *sdk.Config {
 	return c.config
 }
 
+func (c *CatapultWebsocketClientImpl) Topics() Topics {
+	return c.topics
+}
+
+func (c *CatapultWebsocketClientImpl) NewConnectionSubscription() (sub <-chan *ConnectionEvent, subId int, err error) {
+	if c.connections == nil {
+		return nil, 0, ErrNilConnectionPolicy
+	}
+
+	sub, subId = c.connections.new()
+	return sub, subId, nil
+}
+
+func (c *CatapultWebsocketClientImpl) ConnectionUnsubscribe(subId int) error {
+	if c.connections == nil || !c.connections.delete(subId) {
+		return ErrSubscriptionNotFound
+	}
+
+	return nil
+}
+
 func (c *CatapultWebsocketClientImpl) NewBlockSubscription() (sub <-chan *sdk.BlockInfo, subId int, err error) {
-	return subscribe[*sdk.BlockInfo](topicBlock, nil, c.UID, c.messagePublisher, c.blockSubs)
+	return Subscribe[*sdk.BlockInfo](c, topicBlock, nil, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) NewConfirmedAddedSubscription(address *sdk.Address) (sub <-chan sdk.Transaction, subId int, err error) {
-	return subscribe[sdk.Transaction](topicConfirmedAdded, address, c.UID, c.messagePublisher, c.confAddedSubs)
+	return Subscribe[sdk.Transaction](c, topicConfirmedAdded, address, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) NewUnConfirmedAddedSubscription(address *sdk.Address) (sub <-chan sdk.Transaction, subId int, err error) {
-	return subscribe[sdk.Transaction](topicUnconfirmedAdded, address, c.UID, c.messagePublisher, c.unconfAddedSubs)
+	return Subscribe[sdk.Transaction](c, topicUnconfirmedAdded, address, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) NewUnConfirmedRemovedSubscription(address *sdk.Address) (sub <-chan *sdk.UnconfirmedRemoved, subId int, err error) {
-	return subscribe[*sdk.UnconfirmedRemoved](topicUnconfirmedRemoved, address, c.UID, c.messagePublisher, c.unconfRemovedSubs)
+	return Subscribe[*sdk.UnconfirmedRemoved](c, topicUnconfirmedRemoved, address, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) NewCosignatureSubscription(address *sdk.Address) (sub <-chan *sdk.SignerInfo, subId int, err error) {
-	return subscribe[*sdk.SignerInfo](topicCosignature, address, c.UID, c.messagePublisher, c.cosignatureSubs)
+	return Subscribe[*sdk.SignerInfo](c, topicCosignature, address, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) NewPartialAddedSubscription(address *sdk.Address) (sub <-chan *sdk.AggregateTransaction, subId int, err error) {
-	return subscribe[*sdk.AggregateTransaction](topicPartialAdded, address, c.UID, c.messagePublisher, c.partialAddedSubs)
+	return Subscribe[*sdk.AggregateTransaction](c, topicPartialAdded, address, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) NewPartialRemovedSubscription(address *sdk.Address) (sub <-chan *sdk.PartialRemovedInfo, subId int, err error) {
-	return subscribe[*sdk.PartialRemovedInfo](topicPartialRemoved, address, c.UID, c.messagePublisher, c.partialRemovedSubs)
+	return Subscribe[*sdk.PartialRemovedInfo](c, topicPartialRemoved, address, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) NewStatusSubscription(address *sdk.Address) (sub <-chan *sdk.StatusInfo, subId int, err error) {
-	return subscribe[*sdk.StatusInfo](topicStatus, address, c.UID, c.messagePublisher, c.statusSubs)
+	return Subscribe[*sdk.StatusInfo](c, topicStatus, address, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) NewDriveStateSubscription(address *sdk.Address) (sub <-chan *sdk.DriveStateInfo, subId int, err error) {
-	return subscribe[*sdk.DriveStateInfo](topicDriveState, address, c.UID, c.messagePublisher, c.driveStateSubs)
+	return Subscribe[*sdk.DriveStateInfo](c, topicDriveState, address, nil)
 }
 
 func (c *CatapultWebsocketClientImpl) BlockUnsubscribe(subId int) error {
-	return unsubscribe[*sdk.BlockInfo](topicBlock, nil, c.UID, subId, c.blockSubs, c.messagePublisher)
+	return Unsubscribe(c, topicBlock, nil, subId)
 }
 
 func (c *CatapultWebsocketClientImpl) ConfirmedAddedUnsubscribe(address *sdk.Address, subId int) error {
-	return unsubscribe[sdk.Transaction](topicConfirmedAdded, address, c.UID, subId, c.confAddedSubs, c.messagePublisher)
+	return Unsubscribe(c, topicConfirmedAdded, address, subId)
 }
 
 func (c *CatapultWebsocketClientImpl) UnConfirmedAddedUnsubscribe(address *sdk.Address, subId int) error {
-	return unsubscribe[sdk.Transaction](topicUnconfirmedAdded, address, c.UID, subId, c.unconfAddedSubs, c.messagePublisher)
+	return Unsubscribe(c, topicUnconfirmedAdded, address, subId)
 }
 
 func (c *CatapultWebsocketClientImpl) UnConfirmedRemovedUnsubscribe(address *sdk.Address, subId int) error {
-	return unsubscribe[*sdk.UnconfirmedRemoved](topicUnconfirmedRemoved, address, c.UID, subId, c.unconfRemovedSubs, c.messagePublisher)
+	return Unsubscribe(c, topicUnconfirmedRemoved, address, subId)
 }
 
 func (c *CatapultWebsocketClientImpl) CosignatureUnsubscribe(address *sdk.Address, subId int) error {
-	return unsubscribe[*sdk.SignerInfo](topicCosignature, address, c.UID, subId, c.cosignatureSubs, c.messagePublisher)
+	return Unsubscribe(c, topicCosignature, address, subId)
 }
 
 func (c *CatapultWebsocketClientImpl) PartialAddedUnsubscribe(address *sdk.Address, subId int) error {
-	return unsubscribe[*sdk.AggregateTransaction](topicPartialAdded, address, c.UID, subId, c.partialAddedSubs, c.messagePublisher)
+	return Unsubscribe(c, topicPartialAdded, address, subId)
 }
 
 func (c *CatapultWebsocketClientImpl) PartialRemovedUnsubscribe(address *sdk.Address, subId int) error {
-	return unsubscribe[*sdk.PartialRemovedInfo](topicPartialRemoved, address, c.UID, subId, c.partialRemovedSubs, c.messagePublisher)
+	return Unsubscribe(c, topicPartialRemoved, address, subId)
 }
 
 func (c *CatapultWebsocketClientImpl) DriveStateUnsubscribe(address *sdk.Address, subId int) error {
-	return unsubscribe[*sdk.DriveStateInfo](topicDriveState, address, c.UID, subId, c.driveStateSubs, c.messagePublisher)
+	return Unsubscribe(c, topicDriveState, address, subId)
 }
 
 func (c *CatapultWebsocketClientImpl) StatusUnsubscribe(address *sdk.Address, subId int) error {
-	return unsubscribe[*sdk.StatusInfo](topicStatus, address, c.UID, subId, c.statusSubs, c.messagePublisher)
+	return Unsubscribe(c, topicStatus, address, subId)
+}
+
+// registerTopics creates pools of topics of the client, so their notifications are consumed without subscriptions
+func (c *CatapultWebsocketClientImpl) registerTopics() error {
+	generationHash := c.config.GenerationHash
+
+	blockMapper := &trackingMapper[*sdk.BlockInfo]{
+		mapper:  sdk.NewMapper[*sdk.BlockInfo](generationHash, sdk.BlockMapperFunc),
+		cursors: c.cursors,
+		topics:  c.topics,
+		topic:   topicBlock,
+		key:     blockKey,
+	}
+	confirmedAddedMapper := &trackingMapper[sdk.Transaction]{
+		mapper:  sdk.NewMapper[sdk.Transaction](generationHash, sdk.TransactionMapperFunc),
+		cursors: c.cursors,
+		topics:  c.topics,
+		topic:   topicConfirmedAdded,
+		key:     transactionKey,
+	}
+
+	registrations := []error{
+		registerTopic[*sdk.BlockInfo](c.topics, topicBlock, blockMapper),
+		registerTopic[*sdk.SignerInfo](c.topics, topicCosignature, sdk.NewMapper[*sdk.SignerInfo](generationHash, sdk.CosignatureMapperFunc)),
+		registerTopic[*sdk.DriveStateInfo](c.topics, topicDriveState, sdk.NewMapper[*sdk.DriveStateInfo](generationHash, sdk.DriveStateMapperFunc)),
+		registerTopic[sdk.Transaction](c.topics, topicConfirmedAdded, confirmedAddedMapper),
+		registerTopic[*sdk.AggregateTransaction](c.topics, topicPartialAdded, sdk.NewMapper[*sdk.AggregateTransaction](generationHash, sdk.AggregateTransactionMapperFunc)),
+		registerTopic[*sdk.PartialRemovedInfo](c.topics, topicPartialRemoved, sdk.NewMapper[*sdk.PartialRemovedInfo](generationHash, sdk.PartialRemovedMapperFunc)),
+		registerTopic[*sdk.StatusInfo](c.topics, topicStatus, sdk.NewMapper[*sdk.StatusInfo](generationHash, sdk.StatusMapperFunc)),
+		registerTopic[sdk.Transaction](c.topics, topicUnconfirmedAdded, sdk.NewMapper[sdk.Transaction](generationHash, sdk.TransactionMapperFunc)),
+		registerTopic[*sdk.UnconfirmedRemoved](c.topics, topicUnconfirmedRemoved, sdk.NewMapper[*sdk.UnconfirmedRemoved](generationHash, sdk.UnconfirmedRemovedMapperFunc)),
+	}
+
+	for _, err := range registrations {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (c *CatapultWebsocketClientImpl) closeConnection() error {
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+
 	log.Println("closing connection...")
 	if c.conn != nil {
 		if err := c.conn.Close(); err != nil {
@@ -263,23 +326,73 @@ func (c *CatapultWebsocketClientImpl) closeConnection() error {
 	return nil
 }
 
+func (c *CatapultWebsocketClientImpl) getConn() *websocket.Conn {
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+
+	return c.conn
+}
+
+// getPublisher returns the publisher and the uid of the current connection, both are replaced on reconnect
+func (c *CatapultWebsocketClientImpl) getPublisher() (MessagePublisher, string) {
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+
+	return c.messagePublisher, c.UID
+}
+
+func (c *CatapultWebsocketClientImpl) notifyConnection(e *ConnectionEvent) {
+	if c.connections == nil {
+		return
+	}
+
+	e.Url = c.config.UsedBaseUrl
+	if c.connections.notify(e) {
+		log.Printf("websocket: connection state: %s\n", e)
+	}
+}
+
 func (c *CatapultWebsocketClientImpl) startMessageReading(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
+			c.notifyConnection(&ConnectionEvent{State: Closed, Reason: ctx.Err()})
 			return
 		default:
-			_, resp, err := c.conn.ReadMessage()
+			if c.closed.Load() {
+				return
+			}
+
+			conn := c.getConn()
+			if conn == nil {
+				if err := c.reconnect(ctx, ErrConnectionClosed); err != nil {
+					c.notifyConnection(&ConnectionEvent{State: Closed, Reason: err})
+					return
+				}
+				continue
+			}
+
+			_, resp, err := conn.ReadMessage()
 			if err != nil {
-				log.Println("Error reading message:", err)
-				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-					log.Println("Unexpected close error, attempting to reconnect...")
-					c.reconnect(ctx)
-					continue
+				if c.closed.Load() {
+					return
 				}
-				return
+
+				if e, ok := err.(net.Error); ok && e.Timeout() {
+					err = ErrHeartbeatTimeout
+				}
+
+				log.Println("Error reading message, attempting to reconnect:", err)
+				if err := c.reconnect(ctx, err); err != nil {
+					c.notifyConnection(&ConnectionEvent{State: Closed, Reason: err})
+					return
+				}
+				continue
 			}
 
+			c.lastMessage = time.Now()
+			c.extendReadDeadline(conn)
+
 			err = c.publisher.Publish(ctx, resp)
 			if err != nil {
 				log.Printf("Cannot publish ws message:%s\n", err)
@@ -294,7 +407,7 @@ func (c *CatapultWebsocketClientImpl) initNewConnection() error {
 
 	conn, _, err = websocket.DefaultDialer.Dial(newWSUrl(c.config.UsedBaseUrl).String(), nil)
 	if err != nil {
-		for _, u := range c.config.BaseURLs {
+		for _, u := range c.config.GetBaseURLs() {
 
 			if u == c.config.UsedBaseUrl {
 				continue
@@ -316,109 +429,133 @@ func (c *CatapultWebsocketClientImpl) initNewConnection() error {
 
 	resp := new(wsConnectionResponse)
 	if err = conn.ReadJSON(resp); err != nil {
+		conn.Close()
 		return err
 	}
 
+	c.connMutex.Lock()
+	if c.closed.Load() {
+		c.connMutex.Unlock()
+		conn.Close()
+		return ErrConnectionClosed
+	}
+
 	c.UID = resp.Uid
 	c.conn = conn
-
 	c.messagePublisher = newMessagePublisher(c.conn)
+	c.connMutex.Unlock()
+
+	c.lastMessage = time.Now()
+	c.startHeartbeat(conn)
+
 	return nil
 }
 
-func (c *CatapultWebsocketClientImpl) reconnect(ctx context.Context) {
-	c.closeConnection()
+// startHeartbeat pings the node. The connection is considered lost when nothing is received
+// during the ping interval and the pong timeout
+func (c *CatapultWebsocketClientImpl) startHeartbeat(conn *websocket.Conn) {
+	if c.policy == nil || c.policy.readTimeout() == 0 {
+		return
+	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-			log.Println("websocket: connection is failed. Try again after wait period")
-			err := c.initNewConnection()
-			if err != nil {
-				log.Println("websocket: connection is failed. Try again after wait period")
-				select {
-				case <-time.NewTicker(c.config.WsReconnectionTimeout).C:
-					continue
-				}
-			}
+	conn.SetPongHandler(func(string) error {
+		c.extendReadDeadline(conn)
+		return nil
+	})
+	c.extendReadDeadline(conn)
 
-			err = c.updateHandlers()
-			if err != nil {
-				log.Println("websocket: update handles is failed. Try again after timeout period")
-				select {
-				case <-time.NewTicker(c.config.WsReconnectionTimeout).C:
-					continue
-				}
+	go func() {
+		ticker := time.NewTicker(c.policy.PingInterval)
+		defer ticker.Stop()
 
+		for range ticker.C {
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.policy.PongTimeout))
+			if err != nil {
+				return
 			}
-
-			log.Println(fmt.Sprintf("websocket: connection established: %s", c.config.UsedBaseUrl.String()))
-			return
 		}
+	}()
+}
+
+func (c *CatapultWebsocketClientImpl) extendReadDeadline(conn *websocket.Conn) {
+	if c.policy == nil || c.policy.readTimeout() == 0 {
+		return
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(c.policy.readTimeout())); err != nil {
+		log.Printf("websocket: cannot set read deadline: %s\n", err)
 	}
 }
 
-func (c *CatapultWebsocketClientImpl) updateHandlers() error {
-	for _, path := range c.blockSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
-		if err != nil {
-			return err
-		}
+// reconnect waits with the backoff of the policy before every attempt.
+// It returns an error when the client should not reconnect anymore
+func (c *CatapultWebsocketClientImpl) reconnect(ctx context.Context, reason error) error {
+	c.closeConnection()
+
+	policy := c.policy
+	if policy == nil {
+		policy = DefaultConnectionPolicy(c.config)
 	}
 
-	for _, path := range c.confAddedSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
-		if err != nil {
-			return err
+	disconnected := c.lastMessage
+	for attempt := 1; ; attempt++ {
+		if policy.MaxAttempts > 0 && attempt > policy.MaxAttempts {
+			return fmt.Errorf("%w after %d attempts: %s", ErrReconnectionExhausted, policy.MaxAttempts, reason)
 		}
-	}
 
-	for _, path := range c.unconfAddedSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
-		if err != nil {
-			return err
+		c.notifyConnection(&ConnectionEvent{State: Reconnecting, Attempt: attempt, Reason: reason})
+
+		timer := time.NewTimer(policy.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
 		}
-	}
 
-	for _, path := range c.unconfRemovedSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
-		if err != nil {
-			return err
+		if c.closed.Load() {
+			return nil
 		}
-	}
 
-	for _, path := range c.cosignatureSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
-		if err != nil {
-			return err
+		c.notifyConnection(&ConnectionEvent{State: Connecting, Attempt: attempt})
+		if err := c.initNewConnection(); err != nil {
+			log.Printf("websocket: connection is failed: %s\n", err)
+			reason = err
+			continue
 		}
-	}
 
-	for _, path := range c.partialAddedSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
-		if err != nil {
-			return err
+		if err := c.updateHandlers(); err != nil {
+			log.Printf("websocket: update handlers is failed: %s\n", err)
+			reason = err
+			c.closeConnection()
+			continue
 		}
-	}
 
-	for _, path := range c.partialRemovedSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
-		if err != nil {
-			return err
+		gap := Gap{
+			Disconnected: disconnected,
+			Reconnected:  time.Now(),
+			Paths:        c.topics.Paths(),
 		}
-	}
 
-	for _, path := range c.statusSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
-		if err != nil {
-			return err
+		// live notifications are held from now on until missed ones are replayed
+		if c.cursors != nil {
+			c.cursors.beginReplay()
 		}
+
+		c.notifyConnection(&ConnectionEvent{State: Connected, Attempt: attempt, Gap: &gap})
+
+		if c.cursors != nil {
+			go c.replay(ctx, gap)
+		}
+
+		return nil
 	}
+}
 
-	for _, path := range c.driveStateSubs.GetPaths() {
-		err := c.messagePublisher.PublishSubscribeMessage(c.UID, path)
+func (c *CatapultWebsocketClientImpl) updateHandlers() error {
+	publisher, uid := c.getPublisher()
+	for _, path := range c.topics.Paths() {
+		err := publisher.PublishSubscribeMessage(uid, path)
 		if err != nil {
 			return err
 		}
@@ -438,44 +575,3 @@ func newWSUrl(url url.URL) *url.URL {
 
 	return &url
 }
-
-func subscribe[T any](
-	topic subs.Topic,
-	address *sdk.Address,
-	uid string,
-	publisher MessagePublisher,
-	subsPool subs.SubscribersPool[T]) (_ <-chan T, id int, err error) {
-
-	path := subs.NewPath(topic, address)
-	if !subsPool.HasSubscriptions(path) {
-		err := publisher.PublishSubscribeMessage(uid, path.String())
-		if err != nil {
-			log.Printf("Cannot subscribe on %s topic: %s\n", topic, err)
-			return nil, 0, err
-		}
-	}
-
-	sub, id := subsPool.NewSubscription(path)
-	return sub, id, nil
-}
-
-func unsubscribe[T any](
-	topic subs.Topic,
-	address *sdk.Address,
-	uid string,
-	subId int,
-	subsPool subs.SubscribersPool[T],
-	publisher MessagePublisher) error {
-
-	path := subs.NewPath(topic, address)
-	subsPool.CloseSubscription(path, subId)
-	if !subsPool.HasSubscriptions(path) {
-		err := publisher.PublishUnsubscribeMessage(uid, path.String())
-		if err != nil {
-			log.Printf("cannot unsubscribe from %d subscribtion, %s topic for %s address: %s\n", subId, path, address.Address, err)
-			return err
-		}
-	}
-
-	return nil
-}
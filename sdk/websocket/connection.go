@@ -0,0 +1,305 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+// ConnectionState is a state of the websocket connection
+type ConnectionState uint8
+
+const (
+	// Connecting means that the client dials the node
+	Connecting ConnectionState = iota
+	Connected
+	// Reconnecting means that the connection is lost and the client waits before the next attempt
+	Reconnecting
+	// Closed is the final state. The client does not reconnect anymore
+	Closed
+	// Backfilled follows Connected when notifications missed during the gap are replayed into subscriptions
+	Backfilled
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case Connecting:
+		return "Connecting"
+	case Connected:
+		return "Connected"
+	case Reconnecting:
+		return "Reconnecting"
+	case Closed:
+		return "Closed"
+	case Backfilled:
+		return "Backfilled"
+	default:
+		return "Unknown"
+	}
+}
+
+// ConnectionEvent is a change of the connection state
+type ConnectionEvent struct {
+	State ConnectionState
+	Url   url.URL
+	// Attempt is the number of the reconnection attempt
+	Attempt int
+	// Reason is the error which caused reconnection or closing. It is nil when the client is closed by Close
+	Reason error
+	// Gap is set when the connection is established again. Notifications between
+	// Gap.Disconnected and Gap.Reconnected are lost and should be fetched over REST
+	Gap  *Gap
+	Time time.Time
+}
+
+func (e *ConnectionEvent) String() string {
+	return fmt.Sprintf(
+		`{ "State": %s, "Url": %s, "Attempt": %d, "Reason": %v, "Gap": %v }`,
+		e.State,
+		e.Url.String(),
+		e.Attempt,
+		e.Reason,
+		e.Gap,
+	)
+}
+
+// Gap describes the period when the client was disconnected
+type Gap struct {
+	// Disconnected is the time of the last message received before the connection was lost
+	Disconnected time.Time
+	Reconnected  time.Time
+	// Paths are the subscribed paths which missed notifications
+	Paths []string
+	// Backfilled is true when missed blocks and confirmed transactions are replayed into their subscriptions.
+	// It is reported by the Backfilled event, the replay is not finished yet when Connected is sent
+	Backfilled bool
+}
+
+func (g *Gap) String() string {
+	return fmt.Sprintf(
+		`{ "Disconnected": %s, "Reconnected": %s, "Paths": %v, "Backfilled": %t }`,
+		g.Disconnected,
+		g.Reconnected,
+		g.Paths,
+		g.Backfilled,
+	)
+}
+
+// ConnectionPolicy configures reconnection and liveness detection of the client
+type ConnectionPolicy struct {
+	// InitialInterval is the wait before the first reconnection attempt
+	InitialInterval time.Duration
+	// MaxInterval limits the wait between attempts
+	MaxInterval time.Duration
+	// Multiplier increases the wait after every failed attempt
+	Multiplier float64
+	// Jitter is the part of the wait which is randomized, from 0 to 1
+	Jitter float64
+	// MaxAttempts is the number of reconnection attempts before the client is closed. Zero means no limit
+	MaxAttempts int
+	// PingInterval is the period of pings. Zero disables liveness detection
+	PingInterval time.Duration
+	// PongTimeout is how long the client waits for any message after a ping before the connection is considered lost
+	PongTimeout time.Duration
+	// Backfill replays blocks and confirmed transactions missed during reconnection. Nil disables backfill
+	Backfill Backfill
+}
+
+const (
+	DefaultReconnectionInitialInterval = time.Second
+	DefaultReconnectionMultiplier      = 2
+	DefaultReconnectionJitter          = 0.5
+	DefaultPingInterval                = time.Second * 30
+	DefaultPongTimeout                 = time.Second * 10
+)
+
+// returns ConnectionPolicy with unlimited attempts and backfill over REST of the config.
+// Waits between attempts are limited by WsReconnectionTimeout of the config
+func DefaultConnectionPolicy(cfg *sdk.Config) *ConnectionPolicy {
+	maxInterval := cfg.WsReconnectionTimeout
+	if maxInterval <= 0 {
+		maxInterval = sdk.DefaultWebsocketReconnectionTimeout
+	}
+
+	initialInterval := DefaultReconnectionInitialInterval
+	if initialInterval > maxInterval {
+		initialInterval = maxInterval
+	}
+
+	return &ConnectionPolicy{
+		InitialInterval: initialInterval,
+		MaxInterval:     maxInterval,
+		Multiplier:      DefaultReconnectionMultiplier,
+		Jitter:          DefaultReconnectionJitter,
+		PingInterval:    DefaultPingInterval,
+		PongTimeout:     DefaultPongTimeout,
+		Backfill:        NewRestBackfill(sdk.NewClient(nil, cfg)),
+	}
+}
+
+// Backoff returns the wait before the reconnection attempt, starting from 1
+func (p *ConnectionPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	wait := float64(p.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && wait > float64(p.MaxInterval) {
+		wait = float64(p.MaxInterval)
+	}
+
+	jitter := math.Max(0, math.Min(p.Jitter, 1))
+	wait -= wait * jitter * rand.Float64()
+
+	return time.Duration(wait)
+}
+
+func (p *ConnectionPolicy) readTimeout() time.Duration {
+	if p.PingInterval <= 0 {
+		return 0
+	}
+
+	return p.PingInterval + p.PongTimeout
+}
+
+// connectionListeners delivers connection events to every listener in order without blocking the client
+type connectionListeners struct {
+	m         sync.Mutex
+	last      *ConnectionEvent
+	listeners map[int]*connectionListener
+	nextId    int
+}
+
+func newConnectionListeners() *connectionListeners {
+	return &connectionListeners{
+		listeners: make(map[int]*connectionListener),
+	}
+}
+
+// new returns a listener which receives the current state first
+func (l *connectionListeners) new() (_ <-chan *ConnectionEvent, id int) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.nextId++
+	listener := newConnectionListener()
+	l.listeners[l.nextId] = listener
+
+	if l.last != nil {
+		listener.push(l.last)
+	}
+
+	return listener.ch, l.nextId
+}
+
+func (l *connectionListeners) delete(id int) bool {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	listener, ok := l.listeners[id]
+	if !ok {
+		return false
+	}
+
+	listener.close()
+	delete(l.listeners, id)
+
+	return true
+}
+
+// notify returns false when the client is already closed
+func (l *connectionListeners) notify(e *ConnectionEvent) bool {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	if l.last != nil && l.last.State == Closed {
+		return false
+	}
+
+	e.Time = time.Now()
+	l.last = e
+
+	for _, listener := range l.listeners {
+		listener.push(e)
+	}
+
+	return true
+}
+
+type connectionListener struct {
+	m      sync.Mutex
+	cond   *sync.Cond
+	queue  []*ConnectionEvent
+	closed bool
+	done   chan struct{}
+	ch     chan *ConnectionEvent
+}
+
+func newConnectionListener() *connectionListener {
+	l := &connectionListener{
+		done: make(chan struct{}),
+		ch:   make(chan *ConnectionEvent),
+	}
+	l.cond = sync.NewCond(&l.m)
+
+	go l.run()
+
+	return l
+}
+
+func (l *connectionListener) push(e *ConnectionEvent) {
+	l.m.Lock()
+	l.queue = append(l.queue, e)
+	l.m.Unlock()
+
+	l.cond.Signal()
+}
+
+func (l *connectionListener) close() {
+	l.m.Lock()
+	l.closed = true
+	close(l.done)
+	l.m.Unlock()
+
+	l.cond.Signal()
+}
+
+func (l *connectionListener) run() {
+	defer close(l.ch)
+
+	for {
+		l.m.Lock()
+		for len(l.queue) == 0 && !l.closed {
+			l.cond.Wait()
+		}
+
+		if l.closed {
+			l.m.Unlock()
+			return
+		}
+
+		e := l.queue[0]
+		l.queue = l.queue[1:]
+		l.m.Unlock()
+
+		select {
+		case l.ch <- e:
+		case <-l.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+// fakeWsNode accepts connections like the REST server and records subscriptions
+type fakeWsNode struct {
+	*httptest.Server
+
+	m          sync.Mutex
+	conns      []*websocket.Conn
+	subscribed chan string
+	// silent nodes do not read messages, so pings are not answered
+	silent bool
+}
+
+func newFakeWsNode(t *testing.T, silent bool) *fakeWsNode {
+	node := &fakeWsNode{subscribed: make(chan string, 10), silent: silent}
+	upgrader := websocket.Upgrader{}
+
+	node.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		node.m.Lock()
+		node.conns = append(node.conns, conn)
+		node.m.Unlock()
+
+		if err := conn.WriteJSON(&wsConnectionResponse{Uid: "uid"}); err != nil || node.silent {
+			return
+		}
+
+		for {
+			dto := new(subscribeDTO)
+			if err := conn.ReadJSON(dto); err != nil {
+				return
+			}
+
+			if dto.Subscribe != "" {
+				node.subscribed <- dto.Subscribe
+			}
+		}
+	}))
+	t.Cleanup(node.Close)
+
+	return node
+}
+
+func (n *fakeWsNode) dropConnections() {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	for _, conn := range n.conns {
+		conn.Close()
+	}
+	n.conns = nil
+}
+
+func (n *fakeWsNode) config(t *testing.T) *sdk.Config {
+	cfg, err := sdk.NewConfigWithReputation([]string{n.URL}, sdk.PublicTest, nil, time.Second, nil, sdk.DefaultFeeCalculationStrategy)
+	require.NoError(t, err)
+
+	return cfg
+}
+
+func testPolicy() *ConnectionPolicy {
+	return &ConnectionPolicy{
+		InitialInterval: time.Millisecond * 10,
+		MaxInterval:     time.Millisecond * 50,
+		Multiplier:      2,
+		Jitter:          0.5,
+	}
+}
+
+func nextEvent(t *testing.T, events <-chan *ConnectionEvent) *ConnectionEvent {
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(time.Second * 5):
+		t.Fatal("connection event is not received")
+		return nil
+	}
+}
+
+func TestConnectionPolicy_Backoff(t *testing.T) {
+	policy := &ConnectionPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     time.Second * 10,
+		Multiplier:      2,
+		Jitter:          0.5,
+	}
+
+	for attempt, max := range []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 8, time.Second * 10, time.Second * 10} {
+		wait := policy.Backoff(attempt + 1)
+		assert.LessOrEqual(t, wait, max)
+		assert.GreaterOrEqual(t, wait, max/2)
+	}
+
+	policy.Jitter = 0
+	assert.Equal(t, time.Second*4, policy.Backoff(3))
+}
+
+func TestCatapultWebsocketClientImpl_Reconnect(t *testing.T) {
+	node := newFakeWsNode(t, false)
+
+	client, err := NewClientWithPolicy(node.config(t), testPolicy())
+	require.NoError(t, err)
+	defer client.Close()
+
+	events, _, err := client.NewConnectionSubscription()
+	require.NoError(t, err)
+	assert.Equal(t, Connected, nextEvent(t, events).State)
+
+	_, _, err = client.NewBlockSubscription()
+	require.NoError(t, err)
+	assert.Equal(t, "block", <-node.subscribed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.Listen(ctx)
+
+	node.dropConnections()
+
+	e := nextEvent(t, events)
+	assert.Equal(t, Reconnecting, e.State)
+	assert.Equal(t, 1, e.Attempt)
+	assert.Error(t, e.Reason)
+	assert.Equal(t, Connecting, nextEvent(t, events).State)
+
+	e = nextEvent(t, events)
+	require.Equal(t, Connected, e.State)
+	require.NotNil(t, e.Gap)
+	assert.Equal(t, []string{"block"}, e.Gap.Paths)
+	assert.False(t, e.Gap.Reconnected.Before(e.Gap.Disconnected))
+
+	// subscriptions are restored on the new connection
+	assert.Equal(t, "block", <-node.subscribed)
+
+	require.NoError(t, client.Close())
+	e = nextEvent(t, events)
+	assert.Equal(t, Closed, e.State)
+	assert.NoError(t, e.Reason)
+}
+
+func TestCatapultWebsocketClientImpl_ReconnectionExhausted(t *testing.T) {
+	node := newFakeWsNode(t, false)
+
+	policy := testPolicy()
+	policy.MaxAttempts = 2
+	client, err := NewClientWithPolicy(node.config(t), policy)
+	require.NoError(t, err)
+	defer client.Close()
+
+	events, _, err := client.NewConnectionSubscription()
+	require.NoError(t, err)
+	assert.Equal(t, Connected, nextEvent(t, events).State)
+
+	node.dropConnections()
+	node.Close()
+
+	done := make(chan struct{})
+	go func() {
+		client.Listen(context.Background())
+		close(done)
+	}()
+
+	var states []ConnectionState
+	for {
+		e := nextEvent(t, events)
+		states = append(states, e.State)
+		if e.State == Closed {
+			assert.True(t, errors.Is(e.Reason, ErrReconnectionExhausted))
+			break
+		}
+	}
+
+	assert.Equal(t, []ConnectionState{Reconnecting, Connecting, Reconnecting, Connecting, Closed}, states)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("client keeps listening after reconnection attempts are exhausted")
+	}
+}
+
+func TestCatapultWebsocketClientImpl_HeartbeatTimeout(t *testing.T) {
+	node := newFakeWsNode(t, true)
+
+	policy := testPolicy()
+	policy.PingInterval = time.Millisecond * 50
+	policy.PongTimeout = time.Millisecond * 50
+	client, err := NewClientWithPolicy(node.config(t), policy)
+	require.NoError(t, err)
+	defer client.Close()
+
+	events, _, err := client.NewConnectionSubscription()
+	require.NoError(t, err)
+	assert.Equal(t, Connected, nextEvent(t, events).State)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.Listen(ctx)
+
+	e := nextEvent(t, events)
+	assert.Equal(t, Reconnecting, e.State)
+	assert.True(t, errors.Is(e.Reason, ErrHeartbeatTimeout))
+}
+
+func TestCatapultWebsocketClientImpl_ConnectionUnsubscribe(t *testing.T) {
+	node := newFakeWsNode(t, false)
+
+	client, err := NewClientWithPolicy(node.config(t), testPolicy())
+	require.NoError(t, err)
+	defer client.Close()
+
+	events, id, err := client.NewConnectionSubscription()
+	require.NoError(t, err)
+
+	require.NoError(t, client.ConnectionUnsubscribe(id))
+	assert.Equal(t, ErrSubscriptionNotFound, client.ConnectionUnsubscribe(id))
+
+	for range events {
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name MessagePublisher --output ../../mocks/websocket --outpkg mocks
+
 type MessagePublisher interface {
 	PublishSubscribeMessage(uid string, path string) error
 	PublishUnsubscribeMessage(uid string, path string) error
@@ -41,6 +41,19 @@ func (_m *MockSubscribersPool[T]) NewSubscription(path *Path) (<-chan T, int) {
 	return r0, ret.Int(1)
 }
 
+func (_m *MockSubscribersPool[T]) Deliver(ctx context.Context, path *Path, v T) error {
+	ret := _m.Called(ctx, path, v)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Path, T) error); ok {
+		r0 = rf(ctx, path, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 func (_m *MockSubscribersPool[T]) CloseSubscription(path *Path, id int) {
 	_m.Called(path, id)
 }
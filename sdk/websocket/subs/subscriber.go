@@ -16,14 +16,24 @@ type Mapper[T any] interface {
 
 type MapperFunc[T any] func(payload []byte) (T, error)
 
-type SubscribersPool[T any] interface {
+// ErrSkipNotification is returned by a mapper when the payload should not be delivered, e.g. it is a duplicate
+var ErrSkipNotification = errors.New("notification is skipped")
+
+// Pool is a SubscribersPool without the type of notifications, so pools of different topics can be kept together
+type Pool interface {
 	Notifier
-	NewSubscription(path *Path) (_ <-chan T, id int)
 	CloseSubscription(path *Path, id int)
 	GetPaths() []string
 	HasSubscriptions(path *Path) bool
 }
 
+type SubscribersPool[T any] interface {
+	Pool
+	NewSubscription(path *Path) (_ <-chan T, id int)
+	// Deliver sends the value to subscriptions of the path and waits until it is received
+	Deliver(ctx context.Context, path *Path, v T) error
+}
+
 type subscribersPool[T any] struct {
 	dataCh chan []byte
 
@@ -46,6 +56,9 @@ func NewSubscribersPool[T any](mapper Mapper[T]) SubscribersPool[T] {
 
 func (c *subscribersPool[T]) Notify(ctx context.Context, path *Path, payload []byte) error {
 	v, err := c.mapper.Map(payload)
+	if errors.Is(err, ErrSkipNotification) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -68,6 +81,18 @@ func (c *subscribersPool[T]) Notify(ctx context.Context, path *Path, payload []b
 	return nil
 }
 
+func (c *subscribersPool[T]) Deliver(ctx context.Context, path *Path, v T) error {
+	c.subsPerPathsMutex.Lock()
+	defer c.subsPerPathsMutex.Unlock()
+
+	subs, ok := c.subsPerPaths[path.String()]
+	if !ok {
+		return nil
+	}
+
+	return subs.notify(ctx, v)
+}
+
 func (c *subscribersPool[T]) NewSubscription(path *Path) (_ <-chan T, id int) {
 	c.subsPerPathsMutex.Lock()
 	defer c.subsPerPathsMutex.Unlock()
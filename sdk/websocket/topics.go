@@ -0,0 +1,180 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket/subs"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.20.0 --name Topics --output ../../mocks/websocket --outpkg mocks
+
+// Topics keeps subscribers pools of a client by topic. Every subscribed path is registered,
+// so it is subscribed again after reconnection.
+type Topics interface {
+	// Paths returns all subscribed paths
+	Paths() []string
+	// Pool returns the pool of the topic. A new topic gets the pool created by newPool,
+	// or ErrSubscriptionNotFound when newPool is nil
+	Pool(topic subs.Topic, newPool func() subs.Pool) (subs.Pool, error)
+	// Subscribe sends the subscription to the path to the node
+	Subscribe(path *subs.Path) error
+	// Unsubscribe sends the cancellation of the subscription to the path to the node
+	Unsubscribe(path *subs.Path) error
+}
+
+type topics struct {
+	m      sync.Mutex
+	client *CatapultWebsocketClientImpl
+	pools  map[subs.Topic]subs.Pool
+}
+
+func newTopics(client *CatapultWebsocketClientImpl) *topics {
+	return &topics{
+		client: client,
+		pools:  make(map[subs.Topic]subs.Pool),
+	}
+}
+
+func (t *topics) Paths() []string {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	paths := make([]string, 0)
+	for _, pool := range t.pools {
+		paths = append(paths, pool.GetPaths()...)
+	}
+
+	return paths
+}
+
+func (t *topics) Pool(topic subs.Topic, newPool func() subs.Pool) (subs.Pool, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if pool, ok := t.pools[topic]; ok {
+		return pool, nil
+	}
+
+	if newPool == nil {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	pool := newPool()
+	if err := t.client.publisher.AddSubscriber(topic, pool); err != nil {
+		return nil, err
+	}
+
+	t.pools[topic] = pool
+	return pool, nil
+}
+
+// Subscribe starts the backfill cursor of the path, so any subscription of a backfilled topic is replayed after reconnection
+func (t *topics) Subscribe(path *subs.Path) error {
+	if t.client.cursors != nil && backfilled(path.Topic()) {
+		t.client.cursors.start(path)
+	}
+
+	publisher, uid := t.client.getPublisher()
+	return publisher.PublishSubscribeMessage(uid, path.String())
+}
+
+func (t *topics) Unsubscribe(path *subs.Path) error {
+	if t.client.cursors != nil {
+		t.client.cursors.stop(path)
+	}
+
+	publisher, uid := t.client.getPublisher()
+	return publisher.PublishUnsubscribeMessage(uid, path.String())
+}
+
+// registerTopic creates the pool of the topic, so its notifications are consumed before the first subscription
+func registerTopic[T any](t Topics, topic subs.Topic, mapper sdk.Mapper[T]) error {
+	_, err := t.Pool(topic, func() subs.Pool {
+		return subs.NewSubscribersPool[T](mapper)
+	})
+
+	return err
+}
+
+// Subscribe subscribes to the topic of the address. Address is nil for topics without address, e.g. block.
+// Payloads of the topic are mapped by the mapper which created its pool, the mapper of a later subscription
+// is ignored and may be nil. A subscription with another notification type fails with ErrTopicTypeMismatch.
+// It is used by typed subscription methods of the client and allows to consume topics which the client does not know.
+func Subscribe[T any](client CatapultClient, topic subs.Topic, address *sdk.Address, mapper sdk.Mapper[T]) (_ <-chan T, id int, err error) {
+	t := client.Topics()
+	if t == nil {
+		return nil, 0, ErrNoTopics
+	}
+
+	var newPool func() subs.Pool
+	if mapper != nil {
+		newPool = func() subs.Pool {
+			return subs.NewSubscribersPool[T](mapper)
+		}
+	}
+
+	pool, err := typedPool[T](t, topic, newPool)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	path := subs.NewPath(topic, address)
+	if !pool.HasSubscriptions(path) {
+		err := t.Subscribe(path)
+		if err != nil {
+			log.Printf("Cannot subscribe on %s topic: %s\n", topic, err)
+			return nil, 0, err
+		}
+	}
+
+	sub, id := pool.NewSubscription(path)
+	return sub, id, nil
+}
+
+// Unsubscribe closes the subscription returned by Subscribe. The path is unsubscribed on the node
+// when it has no subscriptions left.
+func Unsubscribe(client CatapultClient, topic subs.Topic, address *sdk.Address, subId int) error {
+	t := client.Topics()
+	if t == nil {
+		return ErrNoTopics
+	}
+
+	pool, err := t.Pool(topic, nil)
+	if err != nil {
+		return err
+	}
+
+	path := subs.NewPath(topic, address)
+	pool.CloseSubscription(path, subId)
+	if !pool.HasSubscriptions(path) {
+		err := t.Unsubscribe(path)
+		if err != nil {
+			log.Printf("cannot unsubscribe from %d subscribtion, %s path: %s\n", subId, path, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// typedPool returns the pool of the topic with notifications of type T
+func typedPool[T any](t Topics, topic subs.Topic, newPool func() subs.Pool) (subs.SubscribersPool[T], error) {
+	pool, err := t.Pool(topic, newPool)
+	if err != nil {
+		return nil, err
+	}
+
+	typed, ok := pool.(subs.SubscribersPool[T])
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", topic, ErrTopicTypeMismatch)
+	}
+
+	return typed, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket/subs"
+)
+
+const topicDbrb subs.Topic = "dbrbEvent"
+
+type dbrbEvent struct {
+	View string
+}
+
+func newTestClient(t *testing.T, publisher MessagePublisher) *CatapultWebsocketClientImpl {
+	c := &CatapultWebsocketClientImpl{
+		UID:              "uid",
+		config:           &sdk.Config{},
+		publisher:        subs.NewPublisher(),
+		messagePublisher: publisher,
+	}
+	c.topics = newTopics(c)
+	require.NoError(t, c.registerTopics())
+
+	return c
+}
+
+func dbrbMapper() sdk.Mapper[*dbrbEvent] {
+	return sdk.NewMapper[*dbrbEvent](nil, func(_ *sdk.Hash, payload []byte) (*dbrbEvent, error) {
+		return &dbrbEvent{View: string(payload)}, nil
+	})
+}
+
+func TestSubscribe_CustomTopic(t *testing.T) {
+	publisher := new(MockMessagePublisher)
+	publisher.On("PublishSubscribeMessage", "uid", "dbrbEvent").Return(nil).Once()
+	publisher.On("PublishUnsubscribeMessage", "uid", "dbrbEvent").Return(nil).Once()
+	c := newTestClient(t, publisher)
+
+	first, firstId, err := Subscribe[*dbrbEvent](c, topicDbrb, nil, dbrbMapper())
+	require.NoError(t, err)
+	second, secondId, err := Subscribe[*dbrbEvent](c, topicDbrb, nil, dbrbMapper())
+	require.NoError(t, err)
+
+	payload := []byte(`{"meta":{"channelName":"dbrbEvent"}}`)
+	require.NoError(t, c.publisher.Publish(context.Background(), payload))
+
+	for _, sub := range []<-chan *dbrbEvent{first, second} {
+		select {
+		case e := <-sub:
+			assert.Equal(t, string(payload), e.View)
+		case <-time.After(time.Second):
+			t.Fatal("event is not received")
+		}
+	}
+
+	require.NoError(t, Unsubscribe(c, topicDbrb, nil, firstId))
+	require.NoError(t, Unsubscribe(c, topicDbrb, nil, secondId))
+	publisher.AssertExpectations(t)
+}
+
+func TestSubscribe_TopicTypeMismatch(t *testing.T) {
+	publisher := new(MockMessagePublisher)
+	publisher.On("PublishSubscribeMessage", "uid", "dbrbEvent").Return(nil)
+	c := newTestClient(t, publisher)
+
+	_, _, err := Subscribe[*dbrbEvent](c, topicDbrb, nil, dbrbMapper())
+	require.NoError(t, err)
+
+	_, _, err = Subscribe[string](c, topicDbrb, nil, sdk.NewMapper[string](nil, func(_ *sdk.Hash, payload []byte) (string, error) {
+		return string(payload), nil
+	}))
+	assert.True(t, errors.Is(err, ErrTopicTypeMismatch))
+
+	// topics of the client have the notification types of their methods
+	_, _, err = Subscribe[*dbrbEvent](c, topicStatus, nil, dbrbMapper())
+	assert.True(t, errors.Is(err, ErrTopicTypeMismatch))
+
+	assert.Equal(t, ErrSubscriptionNotFound, Unsubscribe(c, "unknown", nil, 1))
+}
+
+func TestSubscribe_NilMapper(t *testing.T) {
+	c := newTestClient(t, new(MockMessagePublisher))
+
+	_, _, err := Subscribe[*dbrbEvent](c, topicDbrb, nil, nil)
+	assert.Equal(t, ErrSubscriptionNotFound, err)
+}
+
+func TestPublisher_PublishWithoutSubscriptions(t *testing.T) {
+	c := newTestClient(t, new(MockMessagePublisher))
+
+	// notifications of topics of the client are consumed before the first subscription
+	payload := []byte(`{"meta":{"channelName":"status","address":"901CD938C5CE4ED22031C5CE398E618EB1205D5344E2539B58"},` +
+		`"status":"Success","hash":"4D7C1E8B2F0A6E39B5D4C3A2918F7E6D5C4B3A29180F7E6D5C4B3A2918F7E6D5"}`)
+	assert.NoError(t, c.publisher.Publish(context.Background(), payload))
+}
+
+func TestCatapultWebsocketClientImpl_updateHandlers(t *testing.T) {
+	address, err := sdk.NewAddressFromRaw("SAONSOGFZZHNEIBRYXHDTDTBR2YSAXKTITRFHG2Y")
+	require.NoError(t, err)
+
+	publisher := new(MockMessagePublisher)
+	publisher.On("PublishSubscribeMessage", "uid", "block").Return(nil)
+	publisher.On("PublishSubscribeMessage", "uid", "status/"+address.Address).Return(nil)
+	publisher.On("PublishSubscribeMessage", "uid", "dbrbEvent").Return(nil)
+	c := newTestClient(t, publisher)
+
+	_, _, err = c.NewBlockSubscription()
+	require.NoError(t, err)
+	_, _, err = c.NewStatusSubscription(address)
+	require.NoError(t, err)
+	_, _, err = Subscribe[*dbrbEvent](c, topicDbrb, nil, dbrbMapper())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"block", "status/" + address.Address, "dbrbEvent"}, c.Topics().Paths())
+
+	// every path is subscribed again after reconnection
+	reconnected := new(MockMessagePublisher)
+	reconnected.On("PublishSubscribeMessage", "uid", "block").Return(nil).Once()
+	reconnected.On("PublishSubscribeMessage", "uid", "status/"+address.Address).Return(nil).Once()
+	reconnected.On("PublishSubscribeMessage", "uid", "dbrbEvent").Return(nil).Once()
+	c.messagePublisher = reconnected
+
+	require.NoError(t, c.updateHandlers())
+	reconnected.AssertExpectations(t)
+}
+
+func TestTopics_SubscribeDuringReconnect(t *testing.T) {
+	publisher := new(MockMessagePublisher)
+	publisher.On("PublishSubscribeMessage", mock.Anything, "block").Return(nil)
+	c := newTestClient(t, publisher)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			c.connMutex.Lock()
+			c.UID = fmt.Sprintf("uid%d", i)
+			c.connMutex.Unlock()
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, c.Topics().Subscribe(subs.NewPath(topicBlock, nil)))
+	}
+	<-done
+}
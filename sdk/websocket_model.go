@@ -95,7 +95,8 @@ func (dto *WsMessageInfoDTO) ToStruct() (*WsMessageInfo, error) {
 		ChannelName: dto.Meta.ChannelName,
 	}
 
-	if dto.Meta.ChannelName == "block" {
+	// block and other topics without address
+	if dto.Meta.ChannelName == "block" || dto.Meta.Address == "" {
 		return msg, nil
 	}
 
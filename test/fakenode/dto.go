@@ -0,0 +1,230 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package fakenode
+
+// json shapes of the REST and websocket responses, they mirror the unexported DTOs of the sdk package
+
+type uint64DTO [2]uint32
+
+func newUint64DTO(v uint64) uint64DTO {
+	return uint64DTO{uint32(v & 0xFFFFFFFF), uint32(v >> 32)}
+}
+
+func (dto uint64DTO) toUint64() uint64 {
+	return uint64(dto[0]) | uint64(dto[1])<<32
+}
+
+type messageMetaDTO struct {
+	ChannelName string `json:"channelName,omitempty"`
+	Address     string `json:"address,omitempty"`
+}
+
+type mosaicDTO struct {
+	Id     uint64DTO `json:"id"`
+	Amount uint64DTO `json:"amount"`
+}
+
+type accountInfoDTO struct {
+	Meta    struct{} `json:"meta"`
+	Account struct {
+		Address          string       `json:"address"`
+		AddressHeight    uint64DTO    `json:"addressHeight"`
+		PublicKey        string       `json:"publicKey"`
+		PublicKeyHeight  uint64DTO    `json:"publicKeyHeight"`
+		AccountType      int          `json:"accountType"`
+		LinkedAccountKey string       `json:"linkedAccountKey"`
+		Mosaics          []*mosaicDTO `json:"mosaics"`
+	} `json:"account"`
+}
+
+type mosaicPropertyDTO struct {
+	Id    uint8     `json:"id"`
+	Value uint64DTO `json:"value"`
+}
+
+type mosaicInfoDTO struct {
+	Meta   struct{} `json:"meta"`
+	Mosaic struct {
+		MosaicId   uint64DTO            `json:"mosaicId"`
+		Supply     uint64DTO            `json:"supply"`
+		Height     uint64DTO            `json:"height"`
+		Owner      string               `json:"owner"`
+		Revision   uint32               `json:"revision"`
+		Properties []*mosaicPropertyDTO `json:"properties"`
+	} `json:"mosaic"`
+}
+
+type namespaceAliasDTO struct {
+	Type     uint8      `json:"type"`
+	MosaicId *uint64DTO `json:"mosaicId,omitempty"`
+	Address  string     `json:"address,omitempty"`
+}
+
+type namespaceInfoDTO struct {
+	Meta struct {
+		Active bool   `json:"active"`
+		Index  int    `json:"index"`
+		Id     string `json:"id"`
+	} `json:"meta"`
+	Namespace struct {
+		Type         int                `json:"type"`
+		Depth        int                `json:"depth"`
+		Level0       *uint64DTO         `json:"level0,omitempty"`
+		Level1       *uint64DTO         `json:"level1,omitempty"`
+		Level2       *uint64DTO         `json:"level2,omitempty"`
+		Alias        *namespaceAliasDTO `json:"alias"`
+		ParentId     uint64DTO          `json:"parentId"`
+		Owner        string             `json:"owner"`
+		OwnerAddress string             `json:"ownerAddress"`
+		StartHeight  uint64DTO          `json:"startHeight"`
+		EndHeight    uint64DTO          `json:"endHeight"`
+	} `json:"namespace"`
+}
+
+type namespaceNameDTO struct {
+	NamespaceId uint64DTO `json:"namespaceId"`
+	Name        string    `json:"name"`
+	ParentId    uint64DTO `json:"parentId"`
+}
+
+type blockInfoDTO struct {
+	Meta struct {
+		messageMetaDTO
+		Hash            string    `json:"hash"`
+		GenerationHash  string    `json:"generationHash"`
+		TotalFee        uint64DTO `json:"totalFee"`
+		NumTransactions uint64    `json:"numTransactions"`
+	} `json:"meta"`
+	Block struct {
+		Signature              string    `json:"signature"`
+		Signer                 string    `json:"signer"`
+		Version                int64     `json:"version"`
+		Type                   uint64    `json:"type"`
+		Height                 uint64DTO `json:"height"`
+		Timestamp              uint64DTO `json:"timestamp"`
+		Difficulty             uint64DTO `json:"difficulty"`
+		FeeMultiplier          uint32    `json:"feeMultiplier"`
+		PreviousBlockHash      string    `json:"previousBlockHash"`
+		BlockTransactionsHash  string    `json:"blockTransactionsHash"`
+		BlockReceiptsHash      string    `json:"blockReceiptsHash"`
+		StateHash              string    `json:"stateHash"`
+		Beneficiary            string    `json:"beneficiary"`
+		FeeInterest            uint32    `json:"feeInterest"`
+		FeeInterestDenominator uint32    `json:"feeInterestDenominator"`
+	} `json:"block"`
+}
+
+type transactionMetaDTO struct {
+	messageMetaDTO
+	Height              uint64DTO `json:"height"`
+	Index               uint32    `json:"index"`
+	Id                  string    `json:"id"`
+	Hash                string    `json:"hash"`
+	MerkleComponentHash string    `json:"merkleComponentHash"`
+}
+
+type messageDTO struct {
+	Type    uint8  `json:"type"`
+	Payload string `json:"payload"`
+}
+
+type transactionBodyDTO struct {
+	Type      uint16    `json:"type"`
+	Version   int64     `json:"version"`
+	MaxFee    uint64DTO `json:"maxFee"`
+	Deadline  uint64DTO `json:"deadline"`
+	Signature string    `json:"signature"`
+	Signer    string    `json:"signer"`
+
+	// transfer transaction
+	Recipient string       `json:"recipient,omitempty"`
+	Message   *messageDTO  `json:"message,omitempty"`
+	Mosaics   []*mosaicDTO `json:"mosaics,omitempty"`
+}
+
+type transactionDTO struct {
+	Meta        transactionMetaDTO  `json:"meta"`
+	Transaction *transactionBodyDTO `json:"transaction"`
+}
+
+type transactionStatusDTO struct {
+	Group    string          `json:"group"`
+	Status   string          `json:"status"`
+	Hash     string          `json:"hash"`
+	Deadline uint64DTO       `json:"deadline"`
+	Height   uint64DTO       `json:"height"`
+	Meta     *messageMetaDTO `json:"meta,omitempty"`
+}
+
+type unconfirmedRemovedDTO struct {
+	Meta transactionMetaDTO `json:"meta"`
+}
+
+type paginationDTO struct {
+	TotalEntries uint64 `json:"totalEntries"`
+	PageNumber   uint64 `json:"pageNumber"`
+	PageSize     uint64 `json:"pageSize"`
+	TotalPages   uint64 `json:"totalPages"`
+}
+
+type transactionsPageDTO struct {
+	Data       []*transactionDTO `json:"data"`
+	Pagination paginationDTO     `json:"pagination"`
+}
+
+type nodeInfoDTO struct {
+	PublicKey         string `json:"publicKey"`
+	Host              string `json:"host"`
+	FriendlyName      string `json:"friendlyName"`
+	Port              int    `json:"port"`
+	Version           int    `json:"version"`
+	Roles             int    `json:"roles"`
+	NetworkIdentifier uint8  `json:"networkIdentifier"`
+}
+
+type nodeTimeDTO struct {
+	CommunicationTimestamps struct {
+		SendTimestamp    uint64DTO `json:"sendTimestamp"`
+		ReceiveTimestamp uint64DTO `json:"receiveTimestamp"`
+	} `json:"communicationTimestamps"`
+}
+
+type networkDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type networkConfigDTO struct {
+	NetworkConfig struct {
+		Height                  uint64DTO `json:"height"`
+		NetworkConfig           string    `json:"networkConfig"`
+		SupportedEntityVersions string    `json:"supportedEntityVersions"`
+	} `json:"networkConfig"`
+}
+
+type messageDTOResponse struct {
+	Message string `json:"message"`
+}
+
+type announceDTO struct {
+	Payload string `json:"payload"`
+}
+
+type cosignatureDTO struct {
+	ParentHash string `json:"parentHash"`
+	Signature  string `json:"signature"`
+	Signer     string `json:"signer"`
+}
+
+type cosignatureMessageDTO struct {
+	cosignatureDTO
+	Meta messageMetaDTO `json:"meta"`
+}
+
+type subscriptionDTO struct {
+	Uid         string `json:"uid"`
+	Subscribe   string `json:"subscribe"`
+	Unsubscribe string `json:"unsubscribe"`
+}
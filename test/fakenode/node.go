@@ -0,0 +1,734 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// Package fakenode is an in-process stand-in for a catapult REST and websocket node.
+// It keeps accounts, mosaics, namespaces, blocks and transactions in memory, so applications
+// built on the sdk can run end-to-end tests without a real chain.
+//
+// Announced payloads are validated by their header, stay unconfirmed until the next block and then
+// are confirmed or failed. Only transfer transactions change balances, other entity types are
+// confirmed as they are and served with their common fields.
+//
+// The node serves chain, block, account, mosaic, namespace, transaction, transaction status, node,
+// network and config routes. Other routes of the sdk answer 404 "route is not supported by the fake node":
+//   - locks: /lock/hash, /lock/secret, /lock/compositeHash and /account/{id}/lock/{hash|secret}
+//   - metadata: /metadata, /metadata_v2 and the account, mosaic and namespace metadata routes
+//   - storage: /drive, /drives, /downloads, /replicators and the storage v2 routes
+//   - contracts, super contracts, exchanges, liquidity providers and upgrades
+package fakenode
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+const (
+	StatusSuccess             = "Success"
+	StatusPastDeadline        = "Failure_Core_Past_Deadline"
+	StatusInsufficientBalance = "Failure_Core_Insufficient_Balance"
+	StatusInvalidMosaic       = "Failure_Mosaic_Expired"
+
+	groupFailed sdk.TransactionGroup = "failed"
+)
+
+var (
+	ErrAccountNotFound   = errors.New("account is not found")
+	ErrMosaicNotFound    = errors.New("mosaic is not found")
+	ErrNamespaceNotFound = errors.New("namespace is not found")
+	ErrNamespaceExists   = errors.New("namespace is already registered")
+)
+
+// Config of a fake node
+type Config struct {
+	// NetworkType is MijinTest by default
+	NetworkType sdk.NetworkType
+	// GenerationHash is random by default
+	GenerationHash *sdk.Hash
+	// BlockInterval is a period of the simulated block timer.
+	// When it is zero, blocks are generated only by Node.GenerateBlock.
+	BlockInterval time.Duration
+}
+
+type account struct {
+	address         string
+	publicKey       string
+	publicKeyHeight uint64
+	height          uint64
+	balances        map[uint64]uint64
+}
+
+type mosaic struct {
+	id         uint64
+	supply     uint64
+	height     uint64
+	owner      string
+	properties *sdk.MosaicProperties
+}
+
+type namespace struct {
+	id       uint64
+	name     string
+	levels   []uint64
+	owner    string
+	start    uint64
+	end      uint64
+	alias    sdk.AliasType
+	mosaicId uint64
+	address  string
+}
+
+type block struct {
+	height    uint64
+	hash      string
+	prevHash  string
+	timestamp uint64
+	txs       []*transaction
+}
+
+// Node is an in-process fake catapult node
+type Node struct {
+	m      sync.Mutex
+	config Config
+	server *httptest.Server
+	hub    *hub
+	signer string
+	stop   chan struct{}
+	done   chan struct{}
+
+	accounts     map[string]*account
+	mosaics      map[uint64]*mosaic
+	namespaces   map[uint64]*namespace
+	blocks       []*block
+	transactions map[string]*transaction
+	unconfirmed  []*transaction
+	partial      []*transaction
+}
+
+// returns started Node with a nemesis block. It should be closed with Node.Close.
+func New(config Config) *Node {
+	if config.NetworkType == 0 {
+		config.NetworkType = sdk.MijinTest
+	}
+
+	if config.GenerationHash == nil {
+		config.GenerationHash = &sdk.Hash{}
+		copy(config.GenerationHash[:], randomBytes(32))
+	}
+
+	n := &Node{
+		config:       config,
+		hub:          newHub(),
+		signer:       strings.ToUpper(hex.EncodeToString(randomBytes(32))),
+		accounts:     make(map[string]*account),
+		mosaics:      make(map[uint64]*mosaic),
+		namespaces:   make(map[uint64]*namespace),
+		transactions: make(map[string]*transaction),
+	}
+
+	n.blocks = append(n.blocks, n.newBlock(nil))
+	n.server = httptest.NewServer(n.routes())
+
+	if config.BlockInterval > 0 {
+		n.stop, n.done = make(chan struct{}), make(chan struct{})
+		go n.generateBlocks()
+	}
+
+	return n
+}
+
+// URL returns base url of the REST api which should be passed to sdk.NewConfig
+func (n *Node) URL() string {
+	return n.server.URL
+}
+
+func (n *Node) Close() {
+	if n.stop != nil {
+		close(n.stop)
+		<-n.done
+	}
+
+	n.hub.close()
+	n.server.Close()
+}
+
+func (n *Node) generateBlocks() {
+	defer close(n.done)
+
+	ticker := time.NewTicker(n.config.BlockInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-n.stop:
+			return
+		case <-ticker.C:
+			n.GenerateBlock()
+		}
+	}
+}
+
+// Height returns height of the last block
+func (n *Node) Height() sdk.Height {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	return sdk.Height(n.lastBlock().height)
+}
+
+// AddAccount makes the account known to the node with passed balances.
+// Mosaics may be addressed by namespace aliases which are already linked.
+func (n *Node) AddAccount(pa *sdk.PublicAccount, mosaics ...*sdk.Mosaic) error {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	acc := n.account(pa.Address.Address)
+	if len(acc.publicKey) == 0 {
+		acc.publicKey = strings.ToUpper(pa.PublicKey)
+		acc.publicKeyHeight = n.lastBlock().height
+	}
+
+	for _, m := range mosaics {
+		id, ok := n.resolveMosaic(m.AssetId.Id())
+		if !ok {
+			return ErrMosaicNotFound
+		}
+		acc.balances[id] += uint64(m.Amount)
+	}
+
+	return nil
+}
+
+// Balance returns amount of the mosaic owned by the address
+func (n *Node) Balance(address *sdk.Address, mosaicId *sdk.MosaicId) sdk.Amount {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	acc, ok := n.accounts[address.Address]
+	if !ok {
+		return 0
+	}
+
+	return sdk.Amount(acc.balances[mosaicId.Id()])
+}
+
+// AddMosaic defines a mosaic owned by passed account. The whole supply is added to the owner's balance.
+func (n *Node) AddMosaic(owner *sdk.PublicAccount, mosaicId *sdk.MosaicId, supply sdk.Amount, properties *sdk.MosaicProperties) {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	height := n.lastBlock().height
+	n.mosaics[mosaicId.Id()] = &mosaic{
+		id:         mosaicId.Id(),
+		supply:     uint64(supply),
+		height:     height,
+		owner:      strings.ToUpper(owner.PublicKey),
+		properties: properties,
+	}
+
+	acc := n.account(owner.Address.Address)
+	if len(acc.publicKey) == 0 {
+		acc.publicKey = strings.ToUpper(owner.PublicKey)
+		acc.publicKeyHeight = height
+	}
+	acc.balances[mosaicId.Id()] += uint64(supply)
+}
+
+// AddNamespace registers a namespace with all of its parents, e.g. "prx.xpx", for passed duration in blocks
+func (n *Node) AddNamespace(owner *sdk.PublicAccount, name string, duration sdk.Duration) (*sdk.NamespaceId, error) {
+	path, err := sdk.GenerateNamespacePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	id := path[len(path)-1].Id()
+	if _, ok := n.namespaces[id]; ok {
+		return nil, ErrNamespaceExists
+	}
+
+	height := n.lastBlock().height
+	parts := strings.Split(name, ".")
+	levels := make([]uint64, 0, len(path))
+	for i, nsId := range path {
+		levels = append(levels, nsId.Id())
+		if _, ok := n.namespaces[nsId.Id()]; ok {
+			continue
+		}
+
+		n.namespaces[nsId.Id()] = &namespace{
+			id:     nsId.Id(),
+			name:   strings.Join(parts[:i+1], "."),
+			levels: append([]uint64(nil), levels...),
+			owner:  strings.ToUpper(owner.PublicKey),
+			start:  height,
+			end:    height + uint64(duration),
+		}
+	}
+
+	return path[len(path)-1], nil
+}
+
+// LinkMosaic sets mosaic alias of the namespace
+func (n *Node) LinkMosaic(namespaceId *sdk.NamespaceId, mosaicId *sdk.MosaicId) error {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	ns, ok := n.namespaces[namespaceId.Id()]
+	if !ok {
+		return ErrNamespaceNotFound
+	}
+
+	ns.alias, ns.mosaicId, ns.address = sdk.MosaicAliasType, mosaicId.Id(), ""
+	return nil
+}
+
+// LinkAddress sets address alias of the namespace
+func (n *Node) LinkAddress(namespaceId *sdk.NamespaceId, address *sdk.Address) error {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	ns, ok := n.namespaces[namespaceId.Id()]
+	if !ok {
+		return ErrNamespaceNotFound
+	}
+
+	ns.alias, ns.mosaicId, ns.address = sdk.AddressAliasType, 0, address.Address
+	return nil
+}
+
+// Announce accepts a signed payload the same way as PUT /transactions does
+func (n *Node) Announce(payload []byte) (string, error) {
+	n.m.Lock()
+	tx, err := parseTransaction(payload, n.config.GenerationHash)
+	if err != nil {
+		n.m.Unlock()
+		return "", err
+	}
+
+	if _, ok := n.transactions[tx.hash]; !ok {
+		tx.group, tx.status = sdk.Unconfirmed, StatusSuccess
+		n.transactions[tx.hash] = tx
+		n.unconfirmed = append(n.unconfirmed, tx)
+	}
+
+	events := make([]event, 0, 2)
+	for _, address := range tx.addresses() {
+		events = append(events, event{topicPath(topicUnconfirmedAdded, address), tx.toDTO(string(topicUnconfirmedAdded), encodedAddress(address))})
+	}
+	n.m.Unlock()
+
+	n.publish(events)
+
+	return tx.hash, nil
+}
+
+// GenerateBlock confirms every unconfirmed transaction into a new block and returns its height
+func (n *Node) GenerateBlock() sdk.Height {
+	n.m.Lock()
+	now := blockchainTimestamp(time.Now())
+	txs := n.unconfirmed
+	n.unconfirmed = nil
+
+	confirmed := make([]*transaction, 0, len(txs))
+	failed := make([]*transaction, 0)
+	height := n.lastBlock().height + 1
+	for _, tx := range txs {
+		tx.height = height
+		tx.status = n.apply(tx, now)
+		if tx.status != StatusSuccess {
+			tx.group = groupFailed
+			failed = append(failed, tx)
+			continue
+		}
+
+		tx.group = sdk.Confirmed
+		tx.index = uint32(len(confirmed))
+		confirmed = append(confirmed, tx)
+	}
+
+	b := n.newBlock(confirmed)
+	n.blocks = append(n.blocks, b)
+	blockDTO := n.blockDTO(b)
+	blockDTO.Meta.ChannelName = string(topicBlock)
+
+	events := []event{{string(topicBlock), blockDTO}}
+	for _, tx := range txs {
+		for _, address := range tx.addresses() {
+			encoded := encodedAddress(address)
+			events = append(events, event{topicPath(topicUnconfirmedRemoved, address), &unconfirmedRemovedDTO{
+				Meta: transactionMetaDTO{messageMetaDTO: messageMetaDTO{string(topicUnconfirmedRemoved), encoded}, Hash: tx.hash},
+			}})
+
+			if tx.group == sdk.Confirmed {
+				events = append(events, event{topicPath(topicConfirmedAdded, address), tx.toDTO(string(topicConfirmedAdded), encoded)})
+			}
+		}
+	}
+
+	for _, tx := range failed {
+		status := tx.toStatusDTO()
+		status.Meta = &messageMetaDTO{string(topicStatus), encodedAddress(tx.signerAddress)}
+		events = append(events, event{topicPath(topicStatus, tx.signerAddress), status})
+	}
+	n.m.Unlock()
+
+	n.publish(events)
+
+	return sdk.Height(height)
+}
+
+// Rollback drops the last depth blocks like a chain reorganization does and returns the new height.
+// The nemesis block is never dropped. Transactions of dropped blocks return to the unconfirmed pool,
+// balance changes of transfers are reverted. Blocks generated afterwards get new hashes.
+func (n *Node) Rollback(depth int) sdk.Height {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	if depth < 0 {
+		depth = 0
+	}
+
+	if depth > len(n.blocks)-1 {
+		depth = len(n.blocks) - 1
+	}
+
+	dropped := n.blocks[len(n.blocks)-depth:]
+	n.blocks = n.blocks[:len(n.blocks)-depth]
+
+	txs := make([]*transaction, 0)
+	for i := len(dropped) - 1; i >= 0; i-- {
+		for j := len(dropped[i].txs) - 1; j >= 0; j-- {
+			tx := dropped[i].txs[j]
+			n.revert(tx)
+			tx.group, tx.height, tx.index = sdk.Unconfirmed, 0, 0
+			txs = append([]*transaction{tx}, txs...)
+		}
+	}
+	n.unconfirmed = append(txs, n.unconfirmed...)
+
+	return sdk.Height(n.lastBlock().height)
+}
+
+// event is a websocket message prepared under the node lock and published after it is released
+type event struct {
+	path    string
+	message interface{}
+}
+
+func (n *Node) publish(events []event) {
+	for _, e := range events {
+		n.hub.publish(e.path, e.message)
+	}
+}
+
+// apply changes the state by the transaction and returns its status
+func (n *Node) apply(tx *transaction, now uint64) string {
+	if tx.deadline < now {
+		return StatusPastDeadline
+	}
+
+	signer := n.account(tx.signerAddress)
+	if len(signer.publicKey) == 0 {
+		signer.publicKey = tx.signer
+		signer.publicKeyHeight = tx.height
+	}
+
+	if tx.entity != sdk.Transfer {
+		return StatusSuccess
+	}
+
+	amounts, ok := n.transferAmounts(tx)
+	if !ok {
+		return StatusInvalidMosaic
+	}
+
+	for id, amount := range amounts {
+		if signer.balances[id] < amount {
+			return StatusInsufficientBalance
+		}
+	}
+
+	recipient := n.account(n.resolveAddress(tx.recipient))
+	for id, amount := range amounts {
+		signer.balances[id] -= amount
+		recipient.balances[id] += amount
+	}
+	// aliases may be relinked before a rollback, so the revert uses what was applied
+	tx.credited, tx.amounts = recipient.address, amounts
+
+	return StatusSuccess
+}
+
+// revert undoes balance changes of a confirmed transaction.
+// The recipient is never debited below zero, even if its balance was changed by Node.AddAccount meanwhile
+func (n *Node) revert(tx *transaction) {
+	if len(tx.amounts) == 0 {
+		return
+	}
+
+	signer := n.account(tx.signerAddress)
+	recipient := n.account(tx.credited)
+	for id, amount := range tx.amounts {
+		debit := amount
+		if recipient.balances[id] < debit {
+			debit = recipient.balances[id]
+		}
+		recipient.balances[id] -= debit
+		signer.balances[id] += amount
+	}
+	tx.credited, tx.amounts = "", nil
+}
+
+// transferAmounts sums amounts of the transfer by resolved mosaic ids
+func (n *Node) transferAmounts(tx *transaction) (map[uint64]uint64, bool) {
+	amounts := make(map[uint64]uint64, len(tx.mosaics))
+	for _, m := range tx.mosaics {
+		id, ok := n.resolveMosaic(m.Id.toUint64())
+		if !ok {
+			return nil, false
+		}
+		amounts[id] += m.Amount.toUint64()
+	}
+
+	return amounts, true
+}
+
+// resolveMosaic returns mosaic id of passed asset id which may be a namespace alias
+func (n *Node) resolveMosaic(assetId uint64) (uint64, bool) {
+	if assetId&sdk.NamespaceBit == 0 {
+		return assetId, true
+	}
+
+	ns, ok := n.namespaces[assetId]
+	if !ok || ns.alias != sdk.MosaicAliasType {
+		return 0, false
+	}
+
+	return ns.mosaicId, true
+}
+
+// resolveAddress returns an aliased address if passed address is an encoded namespace alias
+func (n *Node) resolveAddress(address string) string {
+	b, err := sdk.NewAddress(address, n.config.NetworkType).Decode()
+	if err != nil || len(b) != addressSize || b[0]&0x01 == 0 {
+		return address
+	}
+
+	ns, ok := n.namespaces[binary.LittleEndian.Uint64(b[1:9])]
+	if !ok || ns.alias != sdk.AddressAliasType {
+		return address
+	}
+
+	return ns.address
+}
+
+func (n *Node) account(address string) *account {
+	acc, ok := n.accounts[address]
+	if !ok {
+		acc = &account{
+			address:  address,
+			height:   n.lastBlock().height,
+			balances: make(map[uint64]uint64),
+		}
+		n.accounts[address] = acc
+	}
+
+	return acc
+}
+
+func (n *Node) lastBlock() *block {
+	return n.blocks[len(n.blocks)-1]
+}
+
+func (n *Node) newBlock(txs []*transaction) *block {
+	b := &block{
+		height:    1,
+		prevHash:  strings.Repeat("0", 64),
+		timestamp: blockchainTimestamp(time.Now()),
+		txs:       txs,
+	}
+
+	if len(n.blocks) > 0 {
+		b.height = n.lastBlock().height + 1
+		b.prevHash = n.lastBlock().hash
+	}
+
+	h := sha3.New256()
+	h.Write([]byte(b.prevHash))
+	for _, tx := range txs {
+		h.Write([]byte(tx.hash))
+	}
+	binary.Write(h, binary.LittleEndian, b.height)
+	// blocks at the same height after a rollback should differ
+	h.Write(randomBytes(8))
+	b.hash = strings.ToUpper(hex.EncodeToString(h.Sum(nil)))
+
+	return b
+}
+
+func (n *Node) blockDTO(b *block) *blockInfoDTO {
+	dto := &blockInfoDTO{}
+	dto.Meta.Hash = b.hash
+	dto.Meta.GenerationHash = b.hash
+	if b.height == 1 {
+		// sdk.NewConfig takes generation hash of the network from the nemesis block
+		dto.Meta.GenerationHash = strings.ToUpper(n.config.GenerationHash.String())
+	}
+	dto.Meta.NumTransactions = uint64(len(b.txs))
+	dto.Block.Signature = strings.Repeat("0", 128)
+	dto.Block.Signer = n.signer
+	dto.Block.Version = int64(n.config.NetworkType)<<24 | 3
+	dto.Block.Type = uint64(sdk.Block)
+	dto.Block.Height = newUint64DTO(b.height)
+	dto.Block.Timestamp = newUint64DTO(b.timestamp)
+	dto.Block.Difficulty = newUint64DTO(100000000000000)
+	dto.Block.PreviousBlockHash = b.prevHash
+	dto.Block.BlockTransactionsHash = strings.Repeat("0", 64)
+	dto.Block.BlockReceiptsHash = strings.Repeat("0", 64)
+	dto.Block.StateHash = strings.Repeat("0", 64)
+	dto.Block.Beneficiary = sdk.EmptyPublicKey
+
+	for _, tx := range b.txs {
+		dto.Meta.TotalFee = newUint64DTO(dto.Meta.TotalFee.toUint64() + tx.maxFee)
+	}
+
+	return dto
+}
+
+func (n *Node) accountDTO(acc *account) *accountInfoDTO {
+	dto := &accountInfoDTO{}
+	dto.Account.Address = encodedAddress(acc.address)
+	dto.Account.AddressHeight = newUint64DTO(acc.height)
+	dto.Account.PublicKey = sdk.EmptyPublicKey
+	if len(acc.publicKey) != 0 {
+		dto.Account.PublicKey = acc.publicKey
+		dto.Account.PublicKeyHeight = newUint64DTO(acc.publicKeyHeight)
+	}
+	dto.Account.AccountType = int(sdk.UnlinkedAccount)
+	dto.Account.LinkedAccountKey = sdk.EmptyPublicKey
+
+	ids := make([]uint64, 0, len(acc.balances))
+	for id := range acc.balances {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	dto.Account.Mosaics = make([]*mosaicDTO, 0, len(ids))
+	for _, id := range ids {
+		dto.Account.Mosaics = append(dto.Account.Mosaics, &mosaicDTO{newUint64DTO(id), newUint64DTO(acc.balances[id])})
+	}
+
+	return dto
+}
+
+func (n *Node) mosaicDTO(m *mosaic) *mosaicInfoDTO {
+	dto := &mosaicInfoDTO{}
+	dto.Mosaic.MosaicId = newUint64DTO(m.id)
+	dto.Mosaic.Supply = newUint64DTO(m.supply)
+	dto.Mosaic.Height = newUint64DTO(m.height)
+	dto.Mosaic.Owner = m.owner
+	dto.Mosaic.Revision = 1
+
+	flags := uint64(0)
+	if m.properties.SupplyMutable {
+		flags |= sdk.Supply_Mutable
+	}
+	if m.properties.Transferable {
+		flags |= sdk.Transferable
+	}
+
+	dto.Mosaic.Properties = []*mosaicPropertyDTO{
+		{uint8(sdk.MosaicPropertyFlagsId), newUint64DTO(flags)},
+		{uint8(sdk.MosaicPropertyDivisibilityId), newUint64DTO(uint64(m.properties.Divisibility))},
+		{uint8(sdk.MosaicPropertyDurationId), newUint64DTO(uint64(m.properties.Duration()))},
+	}
+
+	return dto
+}
+
+func (n *Node) namespaceDTO(ns *namespace) (*namespaceInfoDTO, error) {
+	owner, err := sdk.NewAccountFromPublicKey(ns.owner, n.config.NetworkType)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &namespaceInfoDTO{}
+	dto.Meta.Active = n.lastBlock().height < ns.end
+	// stands for the database id, which doesn't change between requests
+	dto.Meta.Id = fmt.Sprintf("%024X", ns.id)
+	dto.Namespace.Depth = len(ns.levels)
+	dto.Namespace.Owner = ns.owner
+	dto.Namespace.OwnerAddress = encodedAddress(owner.Address.Address)
+	dto.Namespace.StartHeight = newUint64DTO(ns.start)
+	dto.Namespace.EndHeight = newUint64DTO(ns.end)
+	if len(ns.levels) > 1 {
+		dto.Namespace.Type = int(sdk.Sub)
+		dto.Namespace.ParentId = newUint64DTO(ns.levels[len(ns.levels)-2])
+	}
+
+	levels := []**uint64DTO{&dto.Namespace.Level0, &dto.Namespace.Level1, &dto.Namespace.Level2}
+	for i, id := range ns.levels {
+		level := newUint64DTO(id)
+		*levels[i] = &level
+	}
+
+	dto.Namespace.Alias = &namespaceAliasDTO{Type: uint8(ns.alias)}
+	switch ns.alias {
+	case sdk.MosaicAliasType:
+		mosaicId := newUint64DTO(ns.mosaicId)
+		dto.Namespace.Alias.MosaicId = &mosaicId
+	case sdk.AddressAliasType:
+		dto.Namespace.Alias.Address = encodedAddress(ns.address)
+	}
+
+	return dto, nil
+}
+
+func blockchainTimestamp(t time.Time) uint64 {
+	return uint64(t.UnixNano()/int64(time.Millisecond) - sdk.TimestampNemesisBlockMilliseconds)
+}
+
+func randomBytes(size int) []byte {
+	b := make([]byte, size)
+	_, _ = rand.Read(b)
+
+	return b
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// PauseWebsocket drops websocket connections and refuses new ones until ResumeWebsocket,
+// so messages published meanwhile are lost like during a network outage
+func (n *Node) PauseWebsocket() {
+	n.hub.pause(true)
+}
+
+func (n *Node) ResumeWebsocket() {
+	n.hub.pause(false)
+}
+
+// HasSubscribers returns true if any websocket client is subscribed to the path, e.g. "block" or "confirmedAdded/SAXXX"
+func (n *Node) HasSubscribers(path string) bool {
+	return n.hub.subscribed(path)
+}
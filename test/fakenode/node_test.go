@@ -0,0 +1,229 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package fakenode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk/websocket"
+)
+
+var ctx = context.Background()
+
+type fixture struct {
+	node      *Node
+	config    *sdk.Config
+	client    *sdk.Client
+	owner     *sdk.Account
+	recipient *sdk.Account
+	mosaicId  *sdk.MosaicId
+	alias     *sdk.NamespaceId
+}
+
+func newFixture(t *testing.T, config Config) *fixture {
+	f := &fixture{node: New(config)}
+	t.Cleanup(f.node.Close)
+
+	var err error
+	f.config, err = sdk.NewConfig(ctx, []string{f.node.URL()})
+	require.NoError(t, err)
+	f.client = sdk.NewClient(nil, f.config)
+
+	f.owner, err = f.client.NewAccount()
+	require.NoError(t, err)
+	f.recipient, err = f.client.NewAccount()
+	require.NoError(t, err)
+
+	f.mosaicId, err = sdk.NewMosaicId(0x0DC67FBE1CAD29E3)
+	require.NoError(t, err)
+	f.node.AddMosaic(f.owner.PublicAccount, f.mosaicId, 1000, sdk.NewMosaicProperties(true, true, 6, 0))
+
+	f.alias, err = f.node.AddNamespace(f.owner.PublicAccount, "prx.xpx", 1000)
+	require.NoError(t, err)
+	require.NoError(t, f.node.LinkMosaic(f.alias, f.mosaicId))
+
+	return f
+}
+
+func (f *fixture) transfer(t *testing.T, amount sdk.Amount) *sdk.Hash {
+	mosaic, err := sdk.NewMosaic(f.alias, amount)
+	require.NoError(t, err)
+
+	tx, err := f.client.NewTransferTransaction(
+		sdk.NewDeadline(time.Hour),
+		f.recipient.Address,
+		[]*sdk.Mosaic{mosaic},
+		sdk.NewPlainMessage("fake"),
+	)
+	require.NoError(t, err)
+
+	signed, err := f.owner.Sign(tx)
+	require.NoError(t, err)
+
+	_, err = f.client.Transaction.Announce(ctx, signed)
+	require.NoError(t, err)
+
+	return signed.Hash
+}
+
+func TestNode_Transfer(t *testing.T) {
+	f := newFixture(t, Config{})
+
+	hash := f.transfer(t, 10)
+
+	status, err := f.client.Transaction.GetTransactionStatus(ctx, hash.String())
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Unconfirmed, status.Group)
+
+	height := f.node.GenerateBlock()
+
+	status, err = f.client.Transaction.GetTransactionStatus(ctx, hash.String())
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Confirmed, status.Group)
+	assert.Equal(t, height, status.Height)
+
+	info, err := f.client.Account.GetAccountInfo(ctx, f.recipient.Address)
+	require.NoError(t, err)
+	require.Len(t, info.Mosaics, 1)
+	assert.Equal(t, f.mosaicId.Id(), info.Mosaics[0].AssetId.Id())
+	assert.Equal(t, sdk.Amount(10), info.Mosaics[0].Amount)
+	assert.Equal(t, sdk.Amount(990), f.node.Balance(f.owner.Address, f.mosaicId))
+
+	tx, err := f.client.Transaction.GetTransaction(ctx, sdk.Confirmed, hash.String())
+	require.NoError(t, err)
+	transfer, ok := tx.(*sdk.TransferTransaction)
+	require.True(t, ok)
+	assert.Equal(t, f.recipient.Address.Address, transfer.Recipient.Address)
+	assert.Equal(t, "fake", string(transfer.Message.Payload()))
+
+	page, err := f.client.Transaction.GetTransactionsByGroup(ctx, sdk.Confirmed, &sdk.TransactionsPageOptions{
+		RecipientAddress: f.recipient.Address.Address,
+	})
+	require.NoError(t, err)
+	assert.Len(t, page.Transactions, 1)
+
+	block, err := f.client.Blockchain.GetBlockByHeight(ctx, height)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), block.NumTransactions)
+}
+
+func TestNode_FailedTransfer(t *testing.T) {
+	f := newFixture(t, Config{})
+
+	hash := f.transfer(t, 2000)
+	f.node.GenerateBlock()
+
+	status, err := f.client.Transaction.GetTransactionStatus(ctx, hash.String())
+	require.NoError(t, err)
+	assert.Equal(t, StatusInsufficientBalance, status.Status)
+	assert.Equal(t, sdk.Amount(1000), f.node.Balance(f.owner.Address, f.mosaicId))
+}
+
+func TestNode_Rollback(t *testing.T) {
+	f := newFixture(t, Config{})
+
+	hash := f.transfer(t, 10)
+	height := f.node.GenerateBlock()
+	dropped, err := f.client.Blockchain.GetBlockByHeight(ctx, height)
+	require.NoError(t, err)
+
+	assert.Equal(t, height-1, f.node.Rollback(1))
+	assert.Equal(t, sdk.Amount(1000), f.node.Balance(f.owner.Address, f.mosaicId))
+
+	status, err := f.client.Transaction.GetTransactionStatus(ctx, hash.String())
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Unconfirmed, status.Group)
+
+	assert.Equal(t, height, f.node.GenerateBlock())
+	assert.Equal(t, sdk.Amount(990), f.node.Balance(f.owner.Address, f.mosaicId))
+
+	block, err := f.client.Blockchain.GetBlockByHeight(ctx, height)
+	require.NoError(t, err)
+	assert.Equal(t, dropped.PreviousBlockHash, block.PreviousBlockHash)
+	assert.NotEqual(t, dropped.BlockHash, block.BlockHash)
+
+	assert.Equal(t, height, f.node.Rollback(-1))
+
+	// the recipient has spent a part of the transfer meanwhile
+	f.node.m.Lock()
+	f.node.account(f.recipient.Address.Address).balances[f.mosaicId.Id()] = 4
+	f.node.m.Unlock()
+	f.node.Rollback(1)
+	assert.Equal(t, sdk.Amount(0), f.node.Balance(f.recipient.Address, f.mosaicId))
+	assert.Equal(t, sdk.Amount(1000), f.node.Balance(f.owner.Address, f.mosaicId))
+}
+
+func TestNode_State(t *testing.T) {
+	f := newFixture(t, Config{})
+
+	mosaic, err := f.client.Mosaic.GetMosaicInfo(ctx, f.mosaicId)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Amount(1000), mosaic.Supply)
+	assert.Equal(t, uint8(6), mosaic.Properties.Divisibility)
+
+	ns, err := f.client.Namespace.GetNamespaceInfo(ctx, f.alias)
+	require.NoError(t, err)
+	assert.Equal(t, 2, ns.Depth)
+	assert.Equal(t, f.mosaicId.Id(), ns.Alias.MosaicId().Id())
+
+	f.node.m.Lock()
+	first, err := f.node.namespaceDTO(f.node.namespaces[f.alias.Id()])
+	require.NoError(t, err)
+	second, err := f.node.namespaceDTO(f.node.namespaces[f.alias.Id()])
+	require.NoError(t, err)
+	f.node.m.Unlock()
+	assert.Equal(t, first.Meta.Id, second.Meta.Id)
+
+	linked, err := f.client.Namespace.GetLinkedMosaicId(ctx, f.alias)
+	require.NoError(t, err)
+	assert.Equal(t, f.mosaicId.Id(), linked.Id())
+
+	blockTime, err := f.client.BlockGenerationTime(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second*15, blockTime)
+}
+
+func TestNode_Websocket(t *testing.T) {
+	f := newFixture(t, Config{BlockInterval: time.Millisecond * 50})
+
+	ws, err := websocket.NewClient(f.config)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	listenCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go ws.Listen(listenCtx)
+
+	confirmed, _, err := ws.NewConfirmedAddedSubscription(f.recipient.Address)
+	require.NoError(t, err)
+	blocks, _, err := ws.NewBlockSubscription()
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return f.node.HasSubscribers(topicPath(topicConfirmedAdded, f.recipient.Address.Address))
+	}, time.Second, time.Millisecond*10)
+
+	hash := f.transfer(t, 10)
+
+	select {
+	case tx := <-confirmed:
+		assert.Equal(t, hash, tx.GetAbstractTransaction().TransactionHash)
+	case <-time.After(time.Second * 5):
+		t.Fatal("confirmed transaction is not received")
+	}
+
+	select {
+	case block := <-blocks:
+		assert.True(t, block.Height > 1)
+	case <-time.After(time.Second * 5):
+		t.Fatal("block is not received")
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package fakenode
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+const (
+	signatureOffset = 4
+	signerOffset    = signatureOffset + 64
+	versionOffset   = signerOffset + 32
+	typeOffset      = versionOffset + 4
+	maxFeeOffset    = typeOffset + 2
+	deadlineOffset  = maxFeeOffset + 8
+	headerSize      = deadlineOffset + 8
+
+	addressSize = 25
+)
+
+var errInvalidPayload = errors.New("payload is not a valid transaction")
+
+// transaction is an announced entity. Only transfer transactions have a decoded body.
+type transaction struct {
+	hash      string
+	payload   []byte
+	entity    sdk.EntityType
+	version   int64
+	maxFee    uint64
+	deadline  uint64
+	signature string
+	signer    string
+
+	signerAddress string
+	recipient     string
+	message       *messageDTO
+	mosaics       []*mosaicDTO
+	// credited and amounts are resolved when a transfer is applied
+	credited string
+	amounts  map[uint64]uint64
+
+	status string
+	group  sdk.TransactionGroup
+	height uint64
+	index  uint32
+}
+
+func parseTransaction(payload []byte, generationHash *sdk.Hash) (*transaction, error) {
+	if len(payload) < headerSize || int(binary.LittleEndian.Uint32(payload)) != len(payload) {
+		return nil, errInvalidPayload
+	}
+
+	tx := &transaction{
+		hash:      transactionHash(payload, generationHash),
+		payload:   payload,
+		entity:    sdk.EntityType(binary.LittleEndian.Uint16(payload[typeOffset:])),
+		version:   int64(binary.LittleEndian.Uint32(payload[versionOffset:])),
+		maxFee:    binary.LittleEndian.Uint64(payload[maxFeeOffset:]),
+		deadline:  binary.LittleEndian.Uint64(payload[deadlineOffset:]),
+		signature: strings.ToUpper(hex.EncodeToString(payload[signatureOffset:signerOffset])),
+		signer:    strings.ToUpper(hex.EncodeToString(payload[signerOffset:versionOffset])),
+	}
+
+	signerAddress, err := sdk.NewAddressFromPublicKey(tx.signer, tx.networkType())
+	if err != nil {
+		return nil, err
+	}
+	tx.signerAddress = signerAddress.Address
+
+	if tx.entity == sdk.Transfer {
+		if err := tx.parseTransfer(payload[headerSize:]); err != nil {
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
+
+// recipient(25), messageSize(2), numMosaics(1), message type(1) and payload, mosaics(id 8, amount 8)
+func (tx *transaction) parseTransfer(body []byte) error {
+	if len(body) < addressSize+3 {
+		return errInvalidPayload
+	}
+
+	tx.recipient = base32.StdEncoding.EncodeToString(body[:addressSize])
+	messageSize := int(binary.LittleEndian.Uint16(body[addressSize:]))
+	numMosaics := int(body[addressSize+2])
+	body = body[addressSize+3:]
+
+	if messageSize < 1 || len(body) != messageSize+numMosaics*16 {
+		return errInvalidPayload
+	}
+
+	tx.message = &messageDTO{
+		Type:    body[0],
+		Payload: strings.ToUpper(hex.EncodeToString(body[1:messageSize])),
+	}
+	body = body[messageSize:]
+
+	tx.mosaics = make([]*mosaicDTO, numMosaics)
+	for i := range tx.mosaics {
+		tx.mosaics[i] = &mosaicDTO{
+			Id:     newUint64DTO(binary.LittleEndian.Uint64(body[i*16:])),
+			Amount: newUint64DTO(binary.LittleEndian.Uint64(body[i*16+8:])),
+		}
+	}
+
+	return nil
+}
+
+func (tx *transaction) networkType() sdk.NetworkType {
+	return sdk.ExtractNetworkType(tx.version)
+}
+
+// addresses returns every address which websocket subscribers are notified about the transaction
+func (tx *transaction) addresses() []string {
+	if len(tx.recipient) == 0 || tx.recipient == tx.signerAddress {
+		return []string{tx.signerAddress}
+	}
+
+	return []string{tx.signerAddress, tx.recipient}
+}
+
+func (tx *transaction) toDTO(channel, address string) *transactionDTO {
+	body := &transactionBodyDTO{
+		Type:      uint16(tx.entity),
+		Version:   tx.version,
+		MaxFee:    newUint64DTO(tx.maxFee),
+		Deadline:  newUint64DTO(tx.deadline),
+		Signature: tx.signature,
+		Signer:    tx.signer,
+		Message:   tx.message,
+		Mosaics:   tx.mosaics,
+	}
+
+	if len(tx.recipient) != 0 {
+		body.Recipient = encodedAddress(tx.recipient)
+	}
+
+	return &transactionDTO{
+		Meta: transactionMetaDTO{
+			messageMetaDTO:      messageMetaDTO{ChannelName: channel, Address: address},
+			Height:              newUint64DTO(tx.height),
+			Index:               tx.index,
+			Id:                  tx.hash[:24],
+			Hash:                tx.hash,
+			MerkleComponentHash: tx.hash,
+		},
+		Transaction: body,
+	}
+}
+
+func (tx *transaction) toStatusDTO() *transactionStatusDTO {
+	return &transactionStatusDTO{
+		Group:    string(tx.group),
+		Status:   tx.status,
+		Hash:     tx.hash,
+		Deadline: newUint64DTO(tx.deadline),
+		Height:   newUint64DTO(tx.height),
+	}
+}
+
+// the same as sdk transaction hash: half of signature, signer, generation hash and the rest of the payload
+func transactionHash(payload []byte, generationHash *sdk.Hash) string {
+	h := sha3.New256()
+	h.Write(payload[signatureOffset : signatureOffset+32])
+	h.Write(payload[signerOffset:versionOffset])
+	if generationHash != nil {
+		h.Write(generationHash[:])
+	}
+	h.Write(payload[versionOffset:])
+
+	return strings.ToUpper(hex.EncodeToString(h.Sum(nil)))
+}
+
+// encodedAddress converts base32 address into hex of its bytes as REST returns it
+func encodedAddress(address string) string {
+	b, err := base32.StdEncoding.DecodeString(address)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToUpper(hex.EncodeToString(b))
+}
@@ -0,0 +1,634 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package fakenode
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+const (
+	defaultPageSize  = 20
+	maxBlocksLimit   = 100
+	nodeFriendlyName = "fakenode"
+)
+
+func (n *Node) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/chain/height", n.handleChainHeight)
+	mux.HandleFunc("/chain/score", n.handleChainScore)
+	mux.HandleFunc("/block/", n.handleBlock)
+	mux.HandleFunc("/blocks/", n.handleBlocks)
+	mux.HandleFunc("/account", n.handleAccounts)
+	mux.HandleFunc("/account/", n.handleAccount)
+	mux.HandleFunc("/mosaic", n.handleMosaics)
+	mux.HandleFunc("/mosaic/", n.handleMosaic)
+	mux.HandleFunc("/namespace/", n.handleNamespace)
+	mux.HandleFunc("/namespace/names", n.handleNamespaceNames)
+	mux.HandleFunc("/transactions", n.handleAnnounce)
+	mux.HandleFunc("/transactions/partial", n.handleAnnouncePartial)
+	mux.HandleFunc("/transactions/cosignature", n.handleAnnounceCosignature)
+	mux.HandleFunc("/transactions/", n.handleTransactions)
+	mux.HandleFunc("/transactionStatus", n.handleStatuses)
+	mux.HandleFunc("/transactionStatus/", n.handleStatus)
+	mux.HandleFunc("/node/info", n.handleNodeInfo)
+	mux.HandleFunc("/node/time", n.handleNodeTime)
+	mux.HandleFunc("/node/peers", n.handleNodePeers)
+	mux.HandleFunc("/network", n.handleNetwork)
+	mux.HandleFunc("/config/", n.handleConfig)
+	mux.HandleFunc("/ws", n.hub.handle)
+
+	return mux
+}
+
+func (n *Node) handleChainHeight(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]uint64DTO{"height": newUint64DTO(uint64(n.Height()))})
+}
+
+func (n *Node) handleChainScore(w http.ResponseWriter, r *http.Request) {
+	height := uint64(n.Height())
+	writeJSON(w, http.StatusOK, map[string]uint64DTO{"scoreHigh": newUint64DTO(0), "scoreLow": newUint64DTO(height)})
+}
+
+// GET /block/{height}
+func (n *Node) handleBlock(w http.ResponseWriter, r *http.Request) {
+	height, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/block/"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	if height == 0 || height > uint64(len(n.blocks)) {
+		writeError(w, http.StatusNotFound, "block is not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, n.blockDTO(n.blocks[height-1]))
+}
+
+// GET /blocks/{height}/limit/{limit}
+func (n *Node) handleBlocks(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/blocks/"), "/")
+	if len(parts) != 3 || parts[1] != "limit" {
+		writeError(w, http.StatusNotFound, "route is not found")
+		return
+	}
+
+	height, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	}
+
+	limit, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil || limit > maxBlocksLimit {
+		writeError(w, http.StatusConflict, "limit is not valid")
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	dtos := make([]*blockInfoDTO, 0, limit)
+	for h := height; h < height+limit && h <= uint64(len(n.blocks)); h++ {
+		if h == 0 {
+			continue
+		}
+		dtos = append(dtos, n.blockDTO(n.blocks[h-1]))
+	}
+
+	writeJSON(w, http.StatusOK, dtos)
+}
+
+// POST /account
+func (n *Node) handleAccounts(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		Addresses []string `json:"addresses"`
+	}{}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	dtos := make([]*accountInfoDTO, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		if acc := n.findAccount(address); acc != nil {
+			dtos = append(dtos, n.accountDTO(acc))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, dtos)
+}
+
+// GET /account/{address or public key}
+func (n *Node) handleAccount(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/account/")
+	if strings.Contains(id, "/") {
+		writeError(w, http.StatusNotFound, "route is not supported by the fake node")
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	acc := n.findAccount(id)
+	if acc == nil {
+		writeError(w, http.StatusNotFound, ErrAccountNotFound.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, n.accountDTO(acc))
+}
+
+func (n *Node) findAccount(id string) *account {
+	id = strings.ToUpper(strings.Replace(id, "-", "", -1))
+	if acc, ok := n.accounts[id]; ok {
+		return acc
+	}
+
+	for _, acc := range n.accounts {
+		if acc.publicKey == id {
+			return acc
+		}
+	}
+
+	return nil
+}
+
+// POST /mosaic
+func (n *Node) handleMosaics(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		MosaicIds []string `json:"mosaicIds"`
+	}{}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	dtos := make([]*mosaicInfoDTO, 0, len(req.MosaicIds))
+	for _, id := range req.MosaicIds {
+		mosaicId, err := strconv.ParseUint(id, 16, 64)
+		if err != nil {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+
+		if m, ok := n.mosaics[mosaicId]; ok {
+			dtos = append(dtos, n.mosaicDTO(m))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, dtos)
+}
+
+// GET /mosaic/{id}
+func (n *Node) handleMosaic(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/mosaic/")
+	mosaicId, err := strconv.ParseUint(id, 16, 64)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "route is not supported by the fake node")
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	m, ok := n.mosaics[mosaicId]
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrMosaicNotFound.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, n.mosaicDTO(m))
+}
+
+// GET /namespace/{id}
+func (n *Node) handleNamespace(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/namespace/")
+	namespaceId, err := strconv.ParseUint(id, 16, 64)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "route is not supported by the fake node")
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	ns, ok := n.namespaces[namespaceId]
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrNamespaceNotFound.Error())
+		return
+	}
+
+	dto, err := n.namespaceDTO(ns)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto)
+}
+
+// POST /namespace/names
+func (n *Node) handleNamespaceNames(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		NamespaceIds []string `json:"namespaceIds"`
+	}{}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	dtos := make([]*namespaceNameDTO, 0)
+	for _, id := range req.NamespaceIds {
+		namespaceId, err := strconv.ParseUint(id, 16, 64)
+		if err != nil {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+
+		ns, ok := n.namespaces[namespaceId]
+		if !ok {
+			continue
+		}
+
+		// every level of the namespace is returned like the real node does
+		for _, level := range ns.levels {
+			l := n.namespaces[level]
+			dto := &namespaceNameDTO{NamespaceId: newUint64DTO(l.id), Name: l.name[strings.LastIndex(l.name, ".")+1:]}
+			if len(l.levels) > 1 {
+				dto.ParentId = newUint64DTO(l.levels[len(l.levels)-2])
+			}
+			dtos = append(dtos, dto)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, dtos)
+}
+
+// PUT /transactions
+func (n *Node) handleAnnounce(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "only PUT is supported")
+		return
+	}
+
+	payload, ok := decodePayload(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := n.Announce(payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, &messageDTOResponse{"packet 9 was pushed to the network via /transaction"})
+}
+
+// PUT /transactions/partial keeps bonded aggregates in the partial group, they are never confirmed
+func (n *Node) handleAnnouncePartial(w http.ResponseWriter, r *http.Request) {
+	payload, ok := decodePayload(w, r)
+	if !ok {
+		return
+	}
+
+	n.m.Lock()
+	tx, err := parseTransaction(payload, n.config.GenerationHash)
+	if err != nil {
+		n.m.Unlock()
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx.group, tx.status = sdk.Partial, StatusSuccess
+	n.transactions[tx.hash] = tx
+	n.partial = append(n.partial, tx)
+	dto := tx.toDTO(string(topicPartialAdded), encodedAddress(tx.signerAddress))
+	n.m.Unlock()
+
+	n.hub.publish(topicPath(topicPartialAdded, tx.signerAddress), dto)
+
+	writeJSON(w, http.StatusAccepted, &messageDTOResponse{"packet 256 was pushed to the network via /transaction/partial"})
+}
+
+// PUT /transactions/cosignature
+func (n *Node) handleAnnounceCosignature(w http.ResponseWriter, r *http.Request) {
+	req := &cosignatureDTO{}
+	if !decodeBody(w, r, req) {
+		return
+	}
+
+	n.m.Lock()
+	tx, ok := n.transactions[strings.ToUpper(req.ParentHash)]
+	ok = ok && tx.group == sdk.Partial
+	n.m.Unlock()
+	if !ok {
+		writeError(w, http.StatusConflict, "aggregate transaction is not found")
+		return
+	}
+
+	n.hub.publish(topicPath(topicCosignature, tx.signerAddress), &cosignatureMessageDTO{
+		cosignatureDTO: *req,
+		Meta:           messageMetaDTO{string(topicCosignature), encodedAddress(tx.signerAddress)},
+	})
+
+	writeJSON(w, http.StatusAccepted, &messageDTOResponse{"packet 257 was pushed to the network via /transaction/cosignature"})
+}
+
+// GET /transactions/{group}, GET /transactions/{group}/{id}, POST /transactions/{group}
+func (n *Node) handleTransactions(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/transactions/"), "/")
+	group := sdk.TransactionGroup(parts[0])
+
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		n.m.Lock()
+		tx, ok := n.transactions[strings.ToUpper(parts[1])]
+		var dto *transactionDTO
+		if ok && tx.group == group {
+			dto = tx.toDTO("", "")
+		}
+		n.m.Unlock()
+		if dto == nil {
+			writeError(w, http.StatusNotFound, "transaction is not found")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, dto)
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		req := struct {
+			Ids []string `json:"transactionIds"`
+		}{}
+		if !decodeBody(w, r, &req) {
+			return
+		}
+
+		n.m.Lock()
+		dtos := make([]*transactionDTO, 0, len(req.Ids))
+		for _, id := range req.Ids {
+			if tx, ok := n.transactions[strings.ToUpper(id)]; ok && tx.group == group {
+				dtos = append(dtos, tx.toDTO("", ""))
+			}
+		}
+		n.m.Unlock()
+
+		writeJSON(w, http.StatusOK, dtos)
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		n.m.Lock()
+		page := n.transactionsPage(group, r)
+		n.m.Unlock()
+
+		writeJSON(w, http.StatusOK, page)
+	default:
+		writeError(w, http.StatusNotFound, "route is not supported by the fake node")
+	}
+}
+
+// transactionsPage supports height, address, signerPublicKey, recipientAddress, type[] and pagination filters
+func (n *Node) transactionsPage(group sdk.TransactionGroup, r *http.Request) *transactionsPageDTO {
+	query := r.URL.Query()
+	height, _ := strconv.ParseUint(query.Get("height"), 10, 64)
+	fromHeight, _ := strconv.ParseUint(query.Get("fromHeight"), 10, 64)
+	toHeight, _ := strconv.ParseUint(query.Get("toHeight"), 10, 64)
+	address := strings.ToUpper(query.Get("address"))
+	signer := strings.ToUpper(query.Get("signerPublicKey"))
+	recipient := strings.ToUpper(query.Get("recipientAddress"))
+	types := make(map[uint64]bool)
+	for _, t := range query["type[]"] {
+		if v, err := strconv.ParseUint(t, 10, 16); err == nil {
+			types[v] = true
+		}
+	}
+
+	pageSize, _ := strconv.ParseUint(query.Get("pageSize"), 10, 64)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	pageNumber, _ := strconv.ParseUint(query.Get("pageNumber"), 10, 64)
+	if pageNumber == 0 {
+		pageNumber = 1
+	}
+
+	matched := make([]*transaction, 0)
+	for _, tx := range n.transactions {
+		switch {
+		case tx.group != group,
+			height != 0 && tx.height != height,
+			fromHeight != 0 && tx.height < fromHeight,
+			toHeight != 0 && tx.height > toHeight,
+			len(address) != 0 && tx.signerAddress != address && tx.recipient != address,
+			len(signer) != 0 && tx.signer != signer,
+			len(recipient) != 0 && tx.recipient != recipient,
+			len(types) != 0 && !types[uint64(tx.entity)]:
+			continue
+		}
+		matched = append(matched, tx)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].height != matched[j].height {
+			return matched[i].height < matched[j].height
+		}
+		return matched[i].index < matched[j].index
+	})
+
+	if query.Get("order") == "desc" {
+		for i, j := 0, len(matched)-1; i < j; i, j = i+1, j-1 {
+			matched[i], matched[j] = matched[j], matched[i]
+		}
+	}
+
+	page := &transactionsPageDTO{
+		Data: make([]*transactionDTO, 0, pageSize),
+		Pagination: paginationDTO{
+			TotalEntries: uint64(len(matched)),
+			PageNumber:   pageNumber,
+			PageSize:     pageSize,
+			TotalPages:   (uint64(len(matched)) + pageSize - 1) / pageSize,
+		},
+	}
+
+	for i := (pageNumber - 1) * pageSize; i < pageNumber*pageSize && i < uint64(len(matched)); i++ {
+		page.Data = append(page.Data, matched[i].toDTO("", ""))
+	}
+
+	return page
+}
+
+// POST /transactionStatus
+func (n *Node) handleStatuses(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		Hashes []string `json:"hashes"`
+	}{}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	dtos := make([]*transactionStatusDTO, 0, len(req.Hashes))
+	for _, hash := range req.Hashes {
+		if tx, ok := n.transactions[strings.ToUpper(hash)]; ok {
+			dtos = append(dtos, tx.toStatusDTO())
+		}
+	}
+
+	writeJSON(w, http.StatusOK, dtos)
+}
+
+// GET /transactionStatus/{hash}
+func (n *Node) handleStatus(w http.ResponseWriter, r *http.Request) {
+	hash := strings.ToUpper(strings.TrimPrefix(r.URL.Path, "/transactionStatus/"))
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	tx, ok := n.transactions[hash]
+	if !ok {
+		writeError(w, http.StatusNotFound, "transaction is not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tx.toStatusDTO())
+}
+
+func (n *Node) handleNodeInfo(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, n.nodeInfo())
+}
+
+func (n *Node) handleNodeTime(w http.ResponseWriter, r *http.Request) {
+	now := blockchainTimestamp(time.Now())
+
+	dto := &nodeTimeDTO{}
+	dto.CommunicationTimestamps.SendTimestamp = newUint64DTO(now)
+	dto.CommunicationTimestamps.ReceiveTimestamp = newUint64DTO(now)
+
+	writeJSON(w, http.StatusOK, dto)
+}
+
+func (n *Node) handleNodePeers(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, []*nodeInfoDTO{n.nodeInfo()})
+}
+
+func (n *Node) nodeInfo() *nodeInfoDTO {
+	port := 0
+	host := strings.TrimPrefix(n.server.URL, "http://")
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		port, _ = strconv.Atoi(host[i+1:])
+		host = host[:i]
+	}
+
+	return &nodeInfoDTO{
+		PublicKey:         n.signer,
+		Host:              host,
+		FriendlyName:      nodeFriendlyName,
+		Port:              port,
+		Roles:             2,
+		NetworkIdentifier: uint8(n.config.NetworkType),
+	}
+}
+
+func (n *Node) handleNetwork(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &networkDTO{networkName(n.config.NetworkType), "fake network"})
+}
+
+// GET /config/{height} returns network config with block generation time of the block timer
+func (n *Node) handleConfig(w http.ResponseWriter, r *http.Request) {
+	blockTime := n.config.BlockInterval
+	if blockTime == 0 {
+		blockTime = time.Second * 15
+	}
+
+	config := sdk.NewNetworkConfig()
+	chain := sdk.NewConfigBag()
+	chain.Name = "chain"
+	chain.Fields["blockGenerationTargetTime"] = &sdk.Field{Key: "blockGenerationTargetTime", Value: blockTime.String()}
+	config.Sections[chain.Name] = chain
+
+	networkConfig, err := config.MarshalBinary()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entities, err := sdk.NewSupportedEntities().MarshalBinary()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	dto := &networkConfigDTO{}
+	dto.NetworkConfig.Height = newUint64DTO(1)
+	dto.NetworkConfig.NetworkConfig = string(networkConfig)
+	dto.NetworkConfig.SupportedEntityVersions = string(entities)
+
+	writeJSON(w, http.StatusOK, dto)
+}
+
+func networkName(networkType sdk.NetworkType) string {
+	switch networkType {
+	case sdk.Mijin:
+		return "mijin"
+	case sdk.MijinTest:
+		return "mijinTest"
+	case sdk.Public:
+		return "public"
+	case sdk.PublicTest:
+		return "publicTest"
+	case sdk.Private:
+		return "private"
+	case sdk.PrivateTest:
+		return "privateTest"
+	}
+
+	return ""
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	return true
+}
+
+func decodePayload(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	req := &announceDTO{}
+	if !decodeBody(w, r, req) {
+		return nil, false
+	}
+
+	payload, err := hex.DecodeString(req.Payload)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	return payload, true
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]string{"code": http.StatusText(code), "message": message})
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package fakenode
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+type topic string
+
+const (
+	topicBlock              topic = "block"
+	topicConfirmedAdded     topic = "confirmedAdded"
+	topicUnconfirmedAdded   topic = "unconfirmedAdded"
+	topicUnconfirmedRemoved topic = "unconfirmedRemoved"
+	topicStatus             topic = "status"
+	topicPartialAdded       topic = "partialAdded"
+	topicCosignature        topic = "cosignature"
+)
+
+func topicPath(t topic, address string) string {
+	return string(t) + "/" + address
+}
+
+type wsConn struct {
+	m    sync.Mutex
+	conn *websocket.Conn
+	uid  string
+	subs map[string]bool
+}
+
+func (c *wsConn) write(v interface{}) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return c.conn.WriteJSON(v)
+}
+
+// hub keeps websocket connections and their subscriptions
+type hub struct {
+	m        sync.Mutex
+	upgrader websocket.Upgrader
+	conns    map[*wsConn]struct{}
+	// paused hub refuses new connections
+	paused bool
+}
+
+func newHub() *hub {
+	return &hub{
+		conns: make(map[*wsConn]struct{}),
+	}
+}
+
+// handle sends uid of a new connection and reads subscribe/unsubscribe messages until the connection is closed
+func (h *hub) handle(w http.ResponseWriter, r *http.Request) {
+	h.m.Lock()
+	paused := h.paused
+	h.m.Unlock()
+
+	if paused {
+		writeError(w, http.StatusServiceUnavailable, "websocket is paused")
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	c := &wsConn{
+		conn: conn,
+		uid:  strings.ToUpper(hex.EncodeToString(randomBytes(16))),
+		subs: make(map[string]bool),
+	}
+
+	if err := c.write(map[string]string{"uid": c.uid}); err != nil {
+		conn.Close()
+		return
+	}
+
+	h.m.Lock()
+	h.conns[c] = struct{}{}
+	h.m.Unlock()
+
+	defer func() {
+		h.m.Lock()
+		delete(h.conns, c)
+		h.m.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		msg := &subscriptionDTO{}
+		if err := conn.ReadJSON(msg); err != nil {
+			return
+		}
+
+		if msg.Uid != c.uid {
+			continue
+		}
+
+		h.m.Lock()
+		if len(msg.Subscribe) != 0 {
+			c.subs[msg.Subscribe] = true
+		}
+		if len(msg.Unsubscribe) != 0 {
+			delete(c.subs, msg.Unsubscribe)
+		}
+		h.m.Unlock()
+	}
+}
+
+// publish sends the message to every connection subscribed to the path
+func (h *hub) publish(path string, v interface{}) {
+	h.m.Lock()
+	conns := make([]*wsConn, 0, len(h.conns))
+	for c := range h.conns {
+		if c.subs[path] {
+			conns = append(conns, c)
+		}
+	}
+	h.m.Unlock()
+
+	for _, c := range conns {
+		_ = c.write(v)
+	}
+}
+
+// subscribed returns true if any connection listens to the path
+func (h *hub) subscribed(path string) bool {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	for c := range h.conns {
+		if c.subs[path] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *hub) pause(paused bool) {
+	h.m.Lock()
+	h.paused = paused
+	h.m.Unlock()
+
+	if paused {
+		h.close()
+	}
+}
+
+func (h *hub) close() {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	for c := range h.conns {
+		c.conn.Close()
+	}
+}
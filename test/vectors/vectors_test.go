@@ -0,0 +1,202 @@
+// Copyright 2024 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// Package vectors checks the transaction test vectors stored next to it.
+// Every vector keeps a transaction in REST JSON form, its unsigned Bytes(),
+// the payload signed by privateKey with generationHash and the transaction hash.
+// Run `go test ./test/vectors -update` to rewrite the expected encodings after an intended change.
+package vectors
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/proximax-storage/go-xpx-chain-sdk/sdk"
+)
+
+var update = flag.Bool("update", false, "rewrite bytes, payload and hash of the vectors with the current encoding")
+
+// every transaction entity type must have a vector.
+// StartOperation has neither a constructor nor a REST mapping, so there is nothing to check.
+var entityTypes = map[string]sdk.EntityType{
+	"AccountMetadata":                sdk.AccountMetadata,
+	"AccountPropertyAddress":         sdk.AccountPropertyAddress,
+	"AccountPropertyEntityType":      sdk.AccountPropertyEntityType,
+	"AccountPropertyMosaic":          sdk.AccountPropertyMosaic,
+	"AddDbrbProcess":                 sdk.AddDbrbProcess,
+	"AddExchangeOffer":               sdk.AddExchangeOffer,
+	"AddHarvester":                   sdk.AddHarvesterEntityType,
+	"AddOrUpdateDbrbProcess":         sdk.AddOrUpdateDbrbProcess,
+	"AddressAlias":                   sdk.AddressAlias,
+	"AggregateBonded":                sdk.AggregateBonded,
+	"AggregateCompleted":             sdk.AggregateCompleted,
+	"AutomaticExecutionsPayment":     sdk.AutomaticExecutionsPayment,
+	"BlockchainUpgrade":              sdk.BlockchainUpgrade,
+	"CreateLiquidityProvider":        sdk.CreateLiquidityProvider,
+	"DataModification":               sdk.DataModification,
+	"DataModificationApproval":       sdk.DataModificationApproval,
+	"DataModificationCancel":         sdk.DataModificationCancel,
+	"DataModificationSingleApproval": sdk.DataModificationSingleApproval,
+	"Deactivate":                     sdk.Deactivate,
+	"Deploy":                         sdk.Deploy,
+	"DeployContract":                 sdk.DeployContract,
+	"Download":                       sdk.Download,
+	"DownloadApproval":               sdk.DownloadApproval,
+	"DownloadPayment":                sdk.DownloadPayment,
+	"DriveClosure":                   sdk.DriveClosure,
+	"DriveFileSystem":                sdk.DriveFileSystem,
+	"DriveFilesReward":               sdk.DriveFilesReward,
+	"EndDrive":                       sdk.EndDrive,
+	"EndDriveVerification":           sdk.EndDriveVerification,
+	"EndDriveVerificationV2":         sdk.EndDriveVerificationV2,
+	"EndExecute":                     sdk.EndExecute,
+	"EndFileDownload":                sdk.EndFileDownload,
+	"EndOperation":                   sdk.EndOperation,
+	"ExchangeOffer":                  sdk.ExchangeOffer,
+	"FilesDeposit":                   sdk.FilesDeposit,
+	"FinishDownload":                 sdk.FinishDownload,
+	"JoinToDrive":                    sdk.JoinToDrive,
+	"LinkAccount":                    sdk.LinkAccount,
+	"Lock":                           sdk.Lock,
+	"ManualCall":                     sdk.ManualCall,
+	"ManualRateChange":               sdk.ManualRateChange,
+	"MetadataAddress":                sdk.MetadataAddress,
+	"MetadataMosaic":                 sdk.MetadataMosaic,
+	"MetadataNamespace":              sdk.MetadataNamespace,
+	"ModifyContract":                 sdk.ModifyContract,
+	"ModifyMultisig":                 sdk.ModifyMultisig,
+	"MosaicAlias":                    sdk.MosaicAlias,
+	"MosaicDefinition":               sdk.MosaicDefinition,
+	"MosaicMetadata":                 sdk.MosaicMetadata,
+	"MosaicModifyLevy":               sdk.MosaicModifyLevy,
+	"MosaicRemoveLevy":               sdk.MosaicRemoveLevy,
+	"MosaicSupplyChange":             sdk.MosaicSupplyChange,
+	"NamespaceMetadata":              sdk.NamespaceMetadata,
+	"NetworkConfig":                  sdk.NetworkConfigEntityType,
+	"OperationIdentify":              sdk.OperationIdentify,
+	"PlaceSdaExchangeOffer":          sdk.PlaceSdaExchangeOffer,
+	"PrepareBcDrive":                 sdk.PrepareBcDrive,
+	"PrepareDrive":                   sdk.PrepareDrive,
+	"RegisterNamespace":              sdk.RegisterNamespace,
+	"RemoveDbrbProcess":              sdk.RemoveDbrbProcess,
+	"RemoveDbrbProcessByNetwork":     sdk.RemoveDbrbProcessByNetwork,
+	"RemoveExchangeOffer":            sdk.RemoveExchangeOffer,
+	"RemoveHarvester":                sdk.RemoveHarvesterEntityType,
+	"RemoveSdaExchangeOffer":         sdk.RemoveSdaExchangeOffer,
+	"ReplicatorOffboarding":          sdk.ReplicatorOffboarding,
+	"ReplicatorOnboarding":           sdk.ReplicatorOnboarding,
+	"ReplicatorTreeRebuild":          sdk.ReplicatorTreeRebuild,
+	"ReplicatorsCleanup":             sdk.ReplicatorsCleanup,
+	"SecretLock":                     sdk.SecretLock,
+	"SecretProof":                    sdk.SecretProof,
+	"StartDriveVerification":         sdk.StartDriveVerification,
+	"StartExecute":                   sdk.StartExecute,
+	"StartFileDownload":              sdk.StartFileDownload,
+	"StoragePayment":                 sdk.StoragePayment,
+	"SuccessfulEndBatchExecution":    sdk.SuccessfulEndBatchExecution,
+	"SuperContractFileSystem":        sdk.SuperContractFileSystem,
+	"Transfer":                       sdk.Transfer,
+	"UnsuccessfulEndBatchExecution":  sdk.UnsuccessfulEndBatchExecution,
+	"VerificationPayment":            sdk.VerificationPayment,
+}
+
+type vector struct {
+	Name           string          `json:"name"`
+	EntityType     sdk.EntityType  `json:"entityType"`
+	PrivateKey     string          `json:"privateKey"`
+	GenerationHash string          `json:"generationHash"`
+	Transaction    json.RawMessage `json:"transaction"`
+	// empty for entity types which are produced by the network and can't be encoded by the sdk
+	Bytes   string `json:"bytes,omitempty"`
+	Payload string `json:"payload,omitempty"`
+	Hash    string `json:"hash,omitempty"`
+}
+
+func loadVectors(t *testing.T) map[string]*vector {
+	files, err := filepath.Glob("*.json")
+	require.NoError(t, err)
+
+	vectors := make(map[string]*vector, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+
+		v := &vector{}
+		require.NoError(t, json.Unmarshal(data, v), file)
+		vectors[file] = v
+	}
+
+	return vectors
+}
+
+func TestVectors_Coverage(t *testing.T) {
+	covered := make(map[string]sdk.EntityType)
+	for _, v := range loadVectors(t) {
+		covered[v.Name] = v.EntityType
+	}
+
+	assert.Equal(t, entityTypes, covered)
+}
+
+func TestVectors(t *testing.T) {
+	for file, v := range loadVectors(t) {
+		file, v := file, v
+		t.Run(v.Name, func(t *testing.T) {
+			generationHash, err := sdk.StringToHash(v.GenerationHash)
+			require.NoError(t, err)
+
+			tx, err := sdk.MapTransaction(bytes.NewBufferString(`{"transaction":`+string(v.Transaction)+`}`), generationHash)
+			require.NoError(t, err)
+
+			abs := tx.GetAbstractTransaction()
+			assert.Equal(t, v.EntityType, abs.Type)
+
+			account, err := sdk.NewAccountFromPrivateKey(v.PrivateKey, abs.NetworkType, generationHash)
+			require.NoError(t, err)
+			assert.Equal(t, account.PublicAccount.PublicKey, abs.Signer.PublicKey)
+
+			// transactions produced by the network either fail or return nothing
+			b, err := tx.Bytes()
+			if err != nil || len(b) == 0 {
+				require.Empty(t, v.Bytes, "vector has bytes, but the transaction can't be encoded: %v", err)
+				return
+			}
+
+			signed, err := account.Sign(tx)
+			require.NoError(t, err)
+
+			actual := &vector{
+				Bytes:   strings.ToUpper(hex.EncodeToString(b)),
+				Payload: signed.Payload,
+				Hash:    strings.ToUpper(signed.Hash.String()),
+			}
+
+			if *update {
+				v.Bytes, v.Payload, v.Hash = actual.Bytes, actual.Payload, actual.Hash
+				writeVector(t, file, v)
+				return
+			}
+
+			assert.Equal(t, v.Bytes, actual.Bytes, "bytes")
+			assert.Equal(t, v.Payload, actual.Payload, "payload")
+			assert.Equal(t, v.Hash, actual.Hash, "hash")
+		})
+	}
+}
+
+func writeVector(t *testing.T, file string, v *vector) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(file, append(data, '\n'), 0644))
+}